@@ -6,6 +6,21 @@ package features
 func Default() UserFeatures {
 	return UserFeatures{
 		// NOTE: ensure all nested objects are fully populated
+		Dns: DnsFeatures{
+			UseETags: false,
+		},
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy:    false,
+			RecoverSoftDeletedKeyVaults: true,
+			RecoverSoftDeletedKeys:      true,
+			RecoverSoftDeletedSecrets:   true,
+		},
+		LoadBalancer: LoadBalancerFeatures{
+			IgnoreExternallyManagedFrontendIPConfigurations: false,
+		},
+		Network: NetworkFeatures{
+			CheckUsageLimitsDuringPlan: false,
+		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: false,
 		},
@@ -4,11 +4,34 @@
 package features
 
 type UserFeatures struct {
+	Dns                    DnsFeatures
+	KeyVault               KeyVaultFeatures
+	LoadBalancer           LoadBalancerFeatures
+	Network                NetworkFeatures
 	ResourceGroup          ResourceGroupFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 }
 
+type DnsFeatures struct {
+	UseETags bool
+}
+
+type KeyVaultFeatures struct {
+	PurgeSoftDeleteOnDestroy    bool
+	RecoverSoftDeletedKeyVaults bool
+	RecoverSoftDeletedKeys      bool
+	RecoverSoftDeletedSecrets   bool
+}
+
+type LoadBalancerFeatures struct {
+	IgnoreExternallyManagedFrontendIPConfigurations bool
+}
+
+type NetworkFeatures struct {
+	CheckUsageLimitsDuringPlan bool
+}
+
 type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
 }
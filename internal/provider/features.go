@@ -59,6 +59,78 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"dns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"use_etags": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"key_vault": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"purge_soft_delete_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"recover_soft_deleted_key_vaults": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"recover_soft_deleted_keys": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"recover_soft_deleted_secrets": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"load_balancer": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ignore_externally_managed_frontend_ip_configurations": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"network": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"check_usage_limits_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"resource_group": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -139,6 +211,55 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["dns"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			dnsRaw := items[0].(map[string]interface{})
+			if v, ok := dnsRaw["use_etags"]; ok {
+				featuresMap.Dns.UseETags = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["key_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			keyVaultRaw := items[0].(map[string]interface{})
+			if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
+				featuresMap.KeyVault.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_key_vaults"]; ok {
+				featuresMap.KeyVault.RecoverSoftDeletedKeyVaults = v.(bool)
+			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_keys"]; ok {
+				featuresMap.KeyVault.RecoverSoftDeletedKeys = v.(bool)
+			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_secrets"]; ok {
+				featuresMap.KeyVault.RecoverSoftDeletedSecrets = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["load_balancer"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			loadBalancerRaw := items[0].(map[string]interface{})
+			if v, ok := loadBalancerRaw["ignore_externally_managed_frontend_ip_configurations"]; ok {
+				featuresMap.LoadBalancer.IgnoreExternallyManagedFrontendIPConfigurations = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["network"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			networkRaw := items[0].(map[string]interface{})
+			if v, ok := networkRaw["check_usage_limits_during_plan"]; ok {
+				featuresMap.Network.CheckUsageLimitsDuringPlan = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["resource_group"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -21,6 +21,12 @@ func TestExpandFeatures(t *testing.T) {
 			Name:  "Empty Block",
 			Input: []interface{}{},
 			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    false,
+					RecoverSoftDeletedKeyVaults: true,
+					RecoverSoftDeletedKeys:      true,
+					RecoverSoftDeletedSecrets:   true,
+				},
 				VirtualMachine: features.VirtualMachineFeatures{
 					DeleteOSDiskOnDeletion:     true,
 					GracefulShutdown:           false,
@@ -40,6 +46,29 @@ func TestExpandFeatures(t *testing.T) {
 			Name: "Complete Enabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"dns": []interface{}{
+						map[string]interface{}{
+							"use_etags": true,
+						},
+					},
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": true,
+							"recover_soft_deleted_keys":       true,
+							"recover_soft_deleted_secrets":    true,
+						},
+					},
+					"load_balancer": []interface{}{
+						map[string]interface{}{
+							"ignore_externally_managed_frontend_ip_configurations": true,
+						},
+					},
+					"network": []interface{}{
+						map[string]interface{}{
+							"check_usage_limits_during_plan": true,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": true,
@@ -67,6 +96,21 @@ func TestExpandFeatures(t *testing.T) {
 				},
 			},
 			Expected: features.UserFeatures{
+				Dns: features.DnsFeatures{
+					UseETags: true,
+				},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: true,
+					RecoverSoftDeletedKeys:      true,
+					RecoverSoftDeletedSecrets:   true,
+				},
+				LoadBalancer: features.LoadBalancerFeatures{
+					IgnoreExternallyManagedFrontendIPConfigurations: true,
+				},
+				Network: features.NetworkFeatures{
+					CheckUsageLimitsDuringPlan: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
@@ -86,6 +130,29 @@ func TestExpandFeatures(t *testing.T) {
 			Name: "Complete Disabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"dns": []interface{}{
+						map[string]interface{}{
+							"use_etags": false,
+						},
+					},
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    false,
+							"recover_soft_deleted_key_vaults": false,
+							"recover_soft_deleted_keys":       false,
+							"recover_soft_deleted_secrets":    false,
+						},
+					},
+					"load_balancer": []interface{}{
+						map[string]interface{}{
+							"ignore_externally_managed_frontend_ip_configurations": false,
+						},
+					},
+					"network": []interface{}{
+						map[string]interface{}{
+							"check_usage_limits_during_plan": false,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": false,
@@ -113,6 +180,21 @@ func TestExpandFeatures(t *testing.T) {
 				},
 			},
 			Expected: features.UserFeatures{
+				Dns: features.DnsFeatures{
+					UseETags: false,
+				},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    false,
+					RecoverSoftDeletedKeyVaults: false,
+					RecoverSoftDeletedKeys:      false,
+					RecoverSoftDeletedSecrets:   false,
+				},
+				LoadBalancer: features.LoadBalancerFeatures{
+					IgnoreExternallyManagedFrontendIPConfigurations: false,
+				},
+				Network: features.NetworkFeatures{
+					CheckUsageLimitsDuringPlan: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
@@ -139,6 +221,301 @@ func TestExpandFeatures(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesDns(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"dns": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Dns: features.DnsFeatures{
+					UseETags: false,
+				},
+			},
+		},
+		{
+			Name: "Use ETags Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"dns": []interface{}{
+						map[string]interface{}{
+							"use_etags": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Dns: features.DnsFeatures{
+					UseETags: true,
+				},
+			},
+		},
+		{
+			Name: "Use ETags Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"dns": []interface{}{
+						map[string]interface{}{
+							"use_etags": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Dns: features.DnsFeatures{
+					UseETags: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Dns, testCase.Expected.Dns) {
+			t.Fatalf("Expected %+v but got %+v", result.Dns, testCase.Expected.Dns)
+		}
+	}
+}
+
+func TestExpandFeaturesKeyVault(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    false,
+					RecoverSoftDeletedKeyVaults: true,
+					RecoverSoftDeletedKeys:      true,
+					RecoverSoftDeletedSecrets:   true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Delete On Destroy Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": true,
+							"recover_soft_deleted_keys":       true,
+							"recover_soft_deleted_secrets":    true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: true,
+					RecoverSoftDeletedKeys:      true,
+					RecoverSoftDeletedSecrets:   true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Items Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": false,
+							"recover_soft_deleted_keys":       false,
+							"recover_soft_deleted_secrets":    false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: false,
+					RecoverSoftDeletedKeys:      false,
+					RecoverSoftDeletedSecrets:   false,
+				},
+			},
+		},
+		{
+			Name: "Only Recover Soft Deleted Keys Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_keys": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    false,
+					RecoverSoftDeletedKeyVaults: true,
+					RecoverSoftDeletedKeys:      false,
+					RecoverSoftDeletedSecrets:   true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.KeyVault, testCase.Expected.KeyVault) {
+			t.Fatalf("Expected %+v but got %+v", result.KeyVault, testCase.Expected.KeyVault)
+		}
+	}
+}
+
+func TestExpandFeaturesLoadBalancer(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"load_balancer": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				LoadBalancer: features.LoadBalancerFeatures{
+					IgnoreExternallyManagedFrontendIPConfigurations: false,
+				},
+			},
+		},
+		{
+			Name: "Ignore Externally Managed Frontend IP Configurations Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"load_balancer": []interface{}{
+						map[string]interface{}{
+							"ignore_externally_managed_frontend_ip_configurations": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				LoadBalancer: features.LoadBalancerFeatures{
+					IgnoreExternallyManagedFrontendIPConfigurations: true,
+				},
+			},
+		},
+		{
+			Name: "Ignore Externally Managed Frontend IP Configurations Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"load_balancer": []interface{}{
+						map[string]interface{}{
+							"ignore_externally_managed_frontend_ip_configurations": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				LoadBalancer: features.LoadBalancerFeatures{
+					IgnoreExternallyManagedFrontendIPConfigurations: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.LoadBalancer, testCase.Expected.LoadBalancer) {
+			t.Fatalf("Expected %+v but got %+v", result.LoadBalancer, testCase.Expected.LoadBalancer)
+		}
+	}
+}
+
+func TestExpandFeaturesNetwork(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					CheckUsageLimitsDuringPlan: false,
+				},
+			},
+		},
+		{
+			Name: "Check Usage Limits During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"check_usage_limits_during_plan": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					CheckUsageLimitsDuringPlan: true,
+				},
+			},
+		},
+		{
+			Name: "Check Usage Limits During Plan Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"check_usage_limits_during_plan": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					CheckUsageLimitsDuringPlan: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Network, testCase.Expected.Network) {
+			t.Fatalf("Expected %+v but got %+v", result.Network, testCase.Expected.Network)
+		}
+	}
+}
+
 func TestExpandFeaturesResourceGroup(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 )
 
@@ -175,12 +176,11 @@ func linuxSecretSchema() *schema.Schema {
 					MinItems: 1,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
-							// TODO: Uncomment url when keyvault is available
-							/*"url": {
+							"url": {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: azure.ValidateKeyVaultChildId,
-							},*/
+								ValidateFunc: keyVaultValidate.NestedItemId,
+							},
 						},
 					},
 				},
@@ -441,13 +441,12 @@ func winRmListenerSchema() *schema.Schema {
 					}, false),
 				},
 
-				// TODO: Uncomment certificate_url when keyvault is available
-				/*"certificate_url": {
+				"certificate_url": {
 					Type:         schema.TypeString,
 					Optional:     true,
 					ForceNew:     true,
-					ValidateFunc: azure.ValidateKeyVaultChildId,
-				},*/
+					ValidateFunc: keyVaultValidate.NestedItemId,
+				},
 			},
 		},
 	}
@@ -521,12 +520,11 @@ func windowsSecretSchema() *schema.Schema {
 								Type:     schema.TypeString,
 								Required: true,
 							},
-							// TODO: Uncomment url when keyvault is available
-							/*"url": {
+							"url": {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: azure.ValidateKeyVaultChildId,
-							},*/
+								ValidateFunc: keyVaultValidate.NestedItemId,
+							},
 						},
 					},
 				},
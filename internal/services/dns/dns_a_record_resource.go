@@ -68,6 +68,11 @@ func dnsARecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -85,7 +90,8 @@ func dnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	resourceId := parse.NewARecordID(subscriptionId, resGroup, zoneName, name)
 
-	if d.IsNewResource() {
+	// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+	if d.IsNewResource() && !dnsRecordSetUseETags(meta) {
 		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.A)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -111,9 +117,14 @@ func dnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		},
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.A, parameters, eTag, ifNoneMatch); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.A, parameters, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurestack_dns_a_record", resourceId.ID())
+			}
+			return dnsRecordSetConflictError(resourceId)
+		}
 		return fmt.Errorf("creating/updating DNS A Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -146,6 +157,7 @@ func dnsARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("zone_name", id.DnszoneName)
 
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 	d.Set("ttl", resp.TTL)
 
 	if err := d.Set("records", flattenazurestackDnsARecords(resp.ARecords)); err != nil {
@@ -165,7 +177,11 @@ func dnsARecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.AName, dns.A, "")
+	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.AName, dns.A, dnsRecordSetIfMatch(d, meta))
+	if dnsRecordSetResponseWasPreconditionFailed(resp) {
+		return dnsRecordSetConflictError(*id)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("deleting DNS A Record %s: %+v", id.AName, err)
 	}
@@ -98,6 +98,48 @@ func TestAccDnsARecord_withTags(t *testing.T) {
 	})
 }
 
+func TestAccDnsARecord_withETags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_a_record", "test")
+	r := TestAccDnsARecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withETags(data, `"1.2.3.4", "1.2.4.5"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("etag").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withETags(data, `"1.2.3.4", "1.2.4.5", "1.2.3.7"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("records.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsARecord_withETagsRequiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_a_record", "test")
+	r := TestAccDnsARecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withETags(data, `"1.2.3.4", "1.2.4.5"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.withETagsRequiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_dns_a_record"),
+		},
+	})
+}
+
 func (TestAccDnsARecordResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ARecordID(state.ID)
 	if err != nil {
@@ -152,6 +194,50 @@ resource "azurestack_dns_a_record" "import" {
 `, r.basic(data))
 }
 
+func (TestAccDnsARecordResource) withETags(data acceptance.TestData, records string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    dns {
+      use_etags = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_dns_a_record" "test" {
+  name                = "myarecord%[1]d"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  ttl                 = 300
+  records             = [%[3]s]
+}
+`, data.RandomInteger, data.Locations.Primary, records)
+}
+
+func (r TestAccDnsARecordResource) withETagsRequiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_a_record" "import" {
+  name                = azurestack_dns_a_record.test.name
+  resource_group_name = azurestack_dns_a_record.test.resource_group_name
+  zone_name           = azurestack_dns_a_record.test.zone_name
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+`, r.withETags(data, `"1.2.3.4", "1.2.4.5"`))
+}
+
 func (TestAccDnsARecordResource) updateRecords(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
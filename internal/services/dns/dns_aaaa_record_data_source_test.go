@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type DnsAAAARecordDataSource struct{}
+
+func TestAccDnsAAAARecordDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_dns_aaaa_record", "test")
+	r := DnsAAAARecordDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("records.#").HasValue("2"),
+				check.That(data.ResourceName).Key("ttl").HasValue("300"),
+				check.That(data.ResourceName).Key("fqdn").MatchesOtherKey(check.That("azurestack_dns_aaaa_record.test").Key("fqdn")),
+			),
+		},
+	})
+}
+
+func (DnsAAAARecordDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_dns_aaaa_record" "test" {
+  name                = azurestack_dns_aaaa_record.test.name
+  resource_group_name = azurestack_dns_aaaa_record.test.resource_group_name
+  zone_name           = azurestack_dns_aaaa_record.test.zone_name
+}
+`, DnsAAAARecordResource{}.basic(data))
+}
@@ -74,6 +74,11 @@ func dnsAAAARecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -91,7 +96,8 @@ func dnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	resourceId := parse.NewAaaaRecordID(subscriptionId, resGroup, zoneName, name)
 
-	if d.IsNewResource() {
+	// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+	if d.IsNewResource() && !dnsRecordSetUseETags(meta) {
 		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.AAAA)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -117,9 +123,14 @@ func dnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		},
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.AAAA, parameters, eTag, ifNoneMatch); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.AAAA, parameters, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurestack_dns_aaaa_record", resourceId.ID())
+			}
+			return dnsRecordSetConflictError(resourceId)
+		}
 		return fmt.Errorf("creating/updating DNS AAAA Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -152,6 +163,7 @@ func dnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("zone_name", id.DnszoneName)
 
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 	d.Set("ttl", resp.TTL)
 
 	if err := d.Set("records", flattenazurestackDnsAaaaRecords(resp.AaaaRecords)); err != nil {
@@ -171,7 +183,11 @@ func dnsAaaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.AAAAName, dns.AAAA, "")
+	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.AAAAName, dns.AAAA, dnsRecordSetIfMatch(d, meta))
+	if dnsRecordSetResponseWasPreconditionFailed(resp) {
+		return dnsRecordSetConflictError(*id)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("deleting DNS AAAA Record %s: %+v", id.AAAAName, err)
 	}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func dnsCNameRecordDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dnsCNameRecordDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"record": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"ttl": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dnsCNameRecordDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewCnameRecordID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string), d.Get("name").(string))
+
+	return readDnsRecordDataSource(ctx, d, meta, id, dns.CNAME, func(d *pluginsdk.ResourceData, props *dns.RecordSetProperties) error {
+		cname := ""
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			cname = *props.CnameRecord.Cname
+		}
+		d.Set("record", cname)
+		return nil
+	})
+}
@@ -68,6 +68,11 @@ func dnsCNameRecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -85,7 +90,8 @@ func dnsCNameRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	resourceId := parse.NewCnameRecordID(subscriptionId, resGroup, zoneName, name)
 
-	if d.IsNewResource() {
+	// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+	if d.IsNewResource() && !dnsRecordSetUseETags(meta) {
 		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.CNAME)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -115,9 +121,14 @@ func dnsCNameRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		parameters.RecordSetProperties.CnameRecord.Cname = pointer.FromString(record)
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.CNAME, parameters, eTag, ifNoneMatch); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.CNAME, parameters, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurestack_dns_cname_record", resourceId.ID())
+			}
+			return dnsRecordSetConflictError(resourceId)
+		}
 		return fmt.Errorf("creating/updating CNAME Record %q (DNS Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -150,6 +161,7 @@ func dnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("zone_name", id.DnszoneName)
 
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 	d.Set("ttl", resp.TTL)
 
 	if props := resp.RecordSetProperties; props != nil {
@@ -173,7 +185,11 @@ func dnsCNameRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.CNAMEName, dns.CNAME, "")
+	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.CNAMEName, dns.CNAME, dnsRecordSetIfMatch(d, meta))
+	if dnsRecordSetResponseWasPreconditionFailed(resp) {
+		return dnsRecordSetConflictError(*id)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("deleting CNAME Record %q (DNS Zone %q / Resource Group %q): %+v", id.CNAMEName, id.DnszoneName, id.ResourceGroup, err)
 	}
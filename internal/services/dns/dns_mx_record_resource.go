@@ -85,6 +85,11 @@ func dnsMxRecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -102,7 +107,8 @@ func dnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	resourceId := parse.NewMxRecordID(subscriptionId, resGroup, zoneName, name)
 
-	if d.IsNewResource() {
+	// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+	if d.IsNewResource() && !dnsRecordSetUseETags(meta) {
 		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.MX)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -127,7 +133,14 @@ func dnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		},
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.MX, parameters, "", ""); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.MX, parameters, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurestack_dns_mx_record", resourceId.ID())
+			}
+			return dnsRecordSetConflictError(resourceId)
+		}
 		return fmt.Errorf("creating/updating DNS MX Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -161,6 +174,7 @@ func dnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	d.Set("ttl", resp.TTL)
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("record", flattenazurestackDnsMxRecords(resp.MxRecords)); err != nil {
 		return err
@@ -178,7 +192,11 @@ func dnsMxRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.MXName, dns.MX, "")
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.MXName, dns.MX, dnsRecordSetIfMatch(d, meta))
+	if dnsRecordSetResponseWasPreconditionFailed(resp) {
+		return dnsRecordSetConflictError(*id)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("deleting DNS MX Record %s: %+v", id.MXName, err)
 	}
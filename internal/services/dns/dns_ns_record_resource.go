@@ -71,6 +71,11 @@ func dnsNsRecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -88,15 +93,18 @@ func dnsNsRecordCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	resourceId := parse.NewNsRecordID(subscriptionId, resGroup, zoneName, name)
 
-	existing, err := client.Get(ctx, resGroup, zoneName, name, dns.NS)
-	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for presence of existing DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+	// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+	if !dnsRecordSetUseETags(meta) {
+		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.NS)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+			}
 		}
-	}
 
-	if !utils.ResponseWasNotFound(existing.Response) {
-		return tf.ImportAsExistsError("azurestack_dns_ns_record", resourceId.ID())
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurestack_dns_ns_record", resourceId.ID())
+		}
 	}
 
 	ttl := int64(d.Get("ttl").(int))
@@ -114,9 +122,11 @@ func dnsNsRecordCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		},
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.NS, parameters, eTag, ifNoneMatch); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.NS, parameters, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			return tf.ImportAsExistsError("azurestack_dns_ns_record", resourceId.ID())
+		}
 		return fmt.Errorf("creating DNS NS Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -159,9 +169,11 @@ func dnsNsRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		existing.RecordSetProperties.TTL = pointer.FromInt64(int64(d.Get("ttl").(int)))
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS, existing, eTag, ifNoneMatch); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS, existing, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			return dnsRecordSetConflictError(*id)
+		}
 		return fmt.Errorf("updating DNS NS Record %q (Zone %q / Resource Group %q): %s", id.NSName, id.DnszoneName, id.ResourceGroup, err)
 	}
 
@@ -193,6 +205,7 @@ func dnsNsRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	d.Set("ttl", resp.TTL)
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 
 	if props := resp.RecordSetProperties; props != nil {
 		if err := d.Set("records", flattenazurestackDnsNsRecords(props.NsRecords)); err != nil {
@@ -213,7 +226,11 @@ func dnsNsRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS, "")
+	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS, dnsRecordSetIfMatch(d, meta))
+	if dnsRecordSetResponseWasPreconditionFailed(resp) {
+		return dnsRecordSetConflictError(*id)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("deleting DNS NS Record %s: %+v", id.NSName, err)
 	}
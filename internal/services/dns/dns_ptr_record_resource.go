@@ -68,6 +68,11 @@ func dnsPtrRecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -85,7 +90,8 @@ func dnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 
 	resourceId := parse.NewPtrRecordID(subscriptionId, resGroup, zoneName, name)
 
-	if d.IsNewResource() {
+	// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+	if d.IsNewResource() && !dnsRecordSetUseETags(meta) {
 		existing, err := client.Get(ctx, resGroup, zoneName, name, dns.PTR)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -109,9 +115,14 @@ func dnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		},
 	}
 
-	eTag := ""
-	ifNoneMatch := "" // set to empty to allow updates to records after creation
-	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.PTR, parameters, eTag, ifNoneMatch); err != nil {
+	ifMatch, ifNoneMatch := dnsRecordSetConditions(d, meta)
+	if resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.PTR, parameters, ifMatch, ifNoneMatch); err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurestack_dns_ptr_record", resourceId.ID())
+			}
+			return dnsRecordSetConflictError(resourceId)
+		}
 		return fmt.Errorf("creating/updating DNS PTR Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -146,6 +157,7 @@ func dnsPtrRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("zone_name", id.DnszoneName)
 	d.Set("ttl", resp.TTL)
 	d.Set("fqdn", resp.Fqdn)
+	d.Set("etag", resp.Etag)
 
 	if err := d.Set("records", flattenazurestackDnsPtrRecords(resp.PtrRecords)); err != nil {
 		return err
@@ -164,7 +176,11 @@ func dnsPtrRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.PTRName, dns.PTR, "")
+	resp, err := dnsClient.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.PTRName, dns.PTR, dnsRecordSetIfMatch(d, meta))
+	if dnsRecordSetResponseWasPreconditionFailed(resp) {
+		return dnsRecordSetConflictError(*id)
+	}
+
 	if err != nil {
 		if resp.StatusCode == http.StatusNotFound {
 			return nil
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// dnsRecordDataSourceSetRecordsFunc sets the type-specific records for a DNS Record Data Source
+type dnsRecordDataSourceSetRecordsFunc func(d *pluginsdk.ResourceData, props *dns.RecordSetProperties) error
+
+// readDnsRecordDataSource retrieves the Record Set of the specified type and sets the fields shared by each of the
+// DNS Record Data Sources, with setRecords being used to set the records themselves since their schema differs by type
+func readDnsRecordDataSource(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id resourceids.Id, recordType dns.RecordType, setRecords dnsRecordDataSourceSetRecordsFunc) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, zoneName, name, recordType)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: %s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.RecordSetProperties; props != nil {
+		d.Set("ttl", props.TTL)
+		d.Set("fqdn", props.Fqdn)
+
+		if err := setRecords(d, props); err != nil {
+			return err
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Metadata)
+}
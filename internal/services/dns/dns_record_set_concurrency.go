@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// dnsRecordSetUseETags returns whether changes to DNS Record Sets should be made using conditional
+// requests, which is opted into using the `dns.use_etags` feature flag.
+func dnsRecordSetUseETags(meta interface{}) bool {
+	return meta.(*clients.Client).Features.Dns.UseETags
+}
+
+// dnsRecordSetConditions returns the `If-Match` and `If-None-Match` values which should be sent when
+// creating or updating a DNS Record Set. When the feature is enabled new Record Sets are only created
+// when they don't already exist - and existing Record Sets are only updated if they haven't been
+// changed since they were last read into the state.
+func dnsRecordSetConditions(d *pluginsdk.ResourceData, meta interface{}) (ifMatch string, ifNoneMatch string) {
+	if !dnsRecordSetUseETags(meta) {
+		return "", ""
+	}
+
+	if d.IsNewResource() {
+		return "", "*"
+	}
+
+	return d.Get("etag").(string), ""
+}
+
+// dnsRecordSetIfMatch returns the `If-Match` value which should be sent when deleting a DNS Record Set.
+func dnsRecordSetIfMatch(d *pluginsdk.ResourceData, meta interface{}) string {
+	if !dnsRecordSetUseETags(meta) {
+		return ""
+	}
+
+	return d.Get("etag").(string)
+}
+
+func dnsRecordSetResponseWasPreconditionFailed(resp autorest.Response) bool {
+	return resp.Response != nil && resp.StatusCode == http.StatusPreconditionFailed
+}
+
+func dnsRecordSetConflictError(id fmt.Stringer) error {
+	return fmt.Errorf("%s has been modified outside of Terraform since it was last read (the ETag no longer matches) - please run `terraform plan` to pick up the latest changes and try again", id)
+}
@@ -0,0 +1,793 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type DnsRecordSetModel struct {
+	Name              string                    `tfschema:"name"`
+	ResourceGroupName string                    `tfschema:"resource_group_name"`
+	ZoneName          string                    `tfschema:"zone_name"`
+	Type              string                    `tfschema:"type"`
+	TTL               int64                     `tfschema:"ttl"`
+	ARecords          []DnsRecordSetARecord     `tfschema:"a_record"`
+	AaaaRecords       []DnsRecordSetAaaaRecord  `tfschema:"aaaa_record"`
+	CnameRecord       []DnsRecordSetCnameRecord `tfschema:"cname_record"`
+	MxRecords         []DnsRecordSetMxRecord    `tfschema:"mx_record"`
+	NsRecords         []DnsRecordSetNsRecord    `tfschema:"ns_record"`
+	PtrRecords        []DnsRecordSetPtrRecord   `tfschema:"ptr_record"`
+	SoaRecord         []DnsRecordSetSoaRecord   `tfschema:"soa_record"`
+	SrvRecords        []DnsRecordSetSrvRecord   `tfschema:"srv_record"`
+	TxtRecords        []DnsRecordSetTxtRecord   `tfschema:"txt_record"`
+	Tags              map[string]string         `tfschema:"tags"`
+	Fqdn              string                    `tfschema:"fqdn"`
+	Etag              string                    `tfschema:"etag"`
+}
+
+type DnsRecordSetARecord struct {
+	IPv4Address string `tfschema:"ipv4_address"`
+}
+
+type DnsRecordSetAaaaRecord struct {
+	IPv6Address string `tfschema:"ipv6_address"`
+}
+
+type DnsRecordSetCnameRecord struct {
+	Cname string `tfschema:"cname"`
+}
+
+type DnsRecordSetMxRecord struct {
+	Preference int64  `tfschema:"preference"`
+	Exchange   string `tfschema:"exchange"`
+}
+
+type DnsRecordSetNsRecord struct {
+	Nsdname string `tfschema:"nsdname"`
+}
+
+type DnsRecordSetPtrRecord struct {
+	Ptrdname string `tfschema:"ptrdname"`
+}
+
+type DnsRecordSetSoaRecord struct {
+	Email        string `tfschema:"email"`
+	HostName     string `tfschema:"host_name"`
+	ExpireTime   int64  `tfschema:"expire_time"`
+	MinimumTTL   int64  `tfschema:"minimum_ttl"`
+	RefreshTime  int64  `tfschema:"refresh_time"`
+	RetryTime    int64  `tfschema:"retry_time"`
+	SerialNumber int64  `tfschema:"serial_number"`
+}
+
+type DnsRecordSetSrvRecord struct {
+	Priority int64  `tfschema:"priority"`
+	Weight   int64  `tfschema:"weight"`
+	Port     int64  `tfschema:"port"`
+	Target   string `tfschema:"target"`
+}
+
+type DnsRecordSetTxtRecord struct {
+	Value string `tfschema:"value"`
+}
+
+// dnsRecordSetBlocks maps each record type to the block containing its records
+var dnsRecordSetBlocks = map[dns.RecordType]string{
+	dns.A:     "a_record",
+	dns.AAAA:  "aaaa_record",
+	dns.CNAME: "cname_record",
+	dns.MX:    "mx_record",
+	dns.NS:    "ns_record",
+	dns.PTR:   "ptr_record",
+	dns.SOA:   "soa_record",
+	dns.SRV:   "srv_record",
+	dns.TXT:   "txt_record",
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = DnsRecordSetResource{}
+	_ sdk.ResourceWithCustomizeDiff = DnsRecordSetResource{}
+)
+
+type DnsRecordSetResource struct{}
+
+func (r DnsRecordSetResource) ResourceType() string {
+	return "azurestack_dns_record_set"
+}
+
+func (r DnsRecordSetResource) ModelObject() interface{} {
+	return &DnsRecordSetModel{}
+}
+
+func (r DnsRecordSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RecordSetID
+}
+
+func (r DnsRecordSetResource) Arguments() map[string]*pluginsdk.Schema {
+	recordBlocks := make([]string, 0)
+	recordTypes := make([]string, 0)
+	for recordType, block := range dnsRecordSetBlocks {
+		recordBlocks = append(recordBlocks, block)
+		recordTypes = append(recordTypes, string(recordType))
+	}
+	sort.Strings(recordBlocks)
+	sort.Strings(recordTypes)
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"zone_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(recordTypes, false),
+		},
+
+		"ttl": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 2147483647),
+		},
+
+		"a_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ipv4_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+				},
+			},
+		},
+
+		"aaaa_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ipv6_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPv6Address,
+					},
+				},
+			},
+		},
+
+		"cname_record": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"cname": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"mx_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"preference": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+
+					"exchange": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"ns_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"nsdname": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"ptr_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ptrdname": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"soa_record": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"email": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.DnsZoneSOARecordEmail,
+					},
+
+					// the host name of the SOA Record is assigned by Azure and cannot be changed
+					"host_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"expire_time": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      2419200,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"minimum_ttl": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      300,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"refresh_time": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      3600,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"retry_time": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      300,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"serial_number": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
+		"srv_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"priority": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+
+					"weight": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+
+					"target": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"txt_record": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: recordBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1024),
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r DnsRecordSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DnsRecordSetResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			recordType := dns.RecordType(metadata.ResourceDiff.Get("type").(string))
+			if recordType == "" {
+				return nil
+			}
+
+			for blockType, block := range dnsRecordSetBlocks {
+				if blockType == recordType {
+					continue
+				}
+				if v, ok := metadata.ResourceDiff.GetOk(block); ok && dnsRecordSetBlockLength(v) > 0 {
+					return fmt.Errorf("the `%s` block cannot be specified when `type` is `%s`, use the `%s` block instead", block, recordType, dnsRecordSetBlocks[recordType])
+				}
+			}
+
+			if recordType == dns.SOA && metadata.ResourceDiff.Get("name").(string) != "@" {
+				return fmt.Errorf("the `name` of an SOA Record Set must be `@`")
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r DnsRecordSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DnsRecordSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewRecordSetID(subscriptionId, model.ResourceGroupName, model.ZoneName, model.Type, model.Name)
+
+			useETags := dnsRecordSetUseETags(metadata.Client)
+			azureManaged := dnsRecordSetIsAzureManaged(id)
+
+			// when using ETags the check for an existing Record Set is made as a part of the create using `If-None-Match`
+			existing := dns.RecordSet{}
+			if !useETags || azureManaged {
+				resp, err := client.Get(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType))
+				if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+				existing = resp
+
+				// the SOA Record and the NS Records at the apex of the zone always exist, so are updated in-place
+				if !utils.ResponseWasNotFound(existing.Response) && !azureManaged {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			parameters, err := expandDnsRecordSetModel(model, &existing)
+			if err != nil {
+				return err
+			}
+
+			ifMatch, ifNoneMatch := "", ""
+			if useETags {
+				if azureManaged {
+					ifMatch = pointer.ToString(existing.Etag)
+				} else {
+					ifNoneMatch = "*"
+				}
+			}
+
+			if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType), *parameters, ifMatch, ifNoneMatch); err != nil {
+				if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+					if azureManaged {
+						return dnsRecordSetConflictError(id)
+					}
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r DnsRecordSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSetsClient
+
+			id, err := parse.RecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType))
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := flattenDnsRecordSetModel(*id, resp)
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r DnsRecordSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSetsClient
+
+			id, err := parse.RecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsRecordSetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			parameters, err := expandDnsRecordSetModel(model, &existing)
+			if err != nil {
+				return err
+			}
+
+			ifMatch, ifNoneMatch := dnsRecordSetConditions(metadata.ResourceData, metadata.Client)
+			if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType), *parameters, ifMatch, ifNoneMatch); err != nil {
+				if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+					return dnsRecordSetConflictError(*id)
+				}
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r DnsRecordSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSetsClient
+
+			id, err := parse.RecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if dnsRecordSetIsAzureManaged(*id) {
+				metadata.Logger.Infof("%s is managed by Azure and cannot be deleted - removing from state", *id)
+				return nil
+			}
+
+			resp, err := client.Delete(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType), dnsRecordSetIfMatch(metadata.ResourceData, metadata.Client))
+			if dnsRecordSetResponseWasPreconditionFailed(resp) {
+				return dnsRecordSetConflictError(*id)
+			}
+
+			if err != nil && resp.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+// dnsRecordSetIsAzureManaged returns whether the Record Set is created by Azure alongside the DNS Zone, in which case
+// it can be updated but not created or deleted
+func dnsRecordSetIsAzureManaged(id parse.RecordSetId) bool {
+	return id.Name == "@" && (id.RecordType == string(dns.SOA) || id.RecordType == string(dns.NS))
+}
+
+func dnsRecordSetBlockLength(input interface{}) int {
+	switch v := input.(type) {
+	case *pluginsdk.Set:
+		return v.Len()
+	case []interface{}:
+		return len(v)
+	}
+	return 0
+}
+
+func expandDnsRecordSetModel(input DnsRecordSetModel, existing *dns.RecordSet) (*dns.RecordSet, error) {
+	props := dns.RecordSetProperties{
+		Metadata: tags.FromTypedObject(input.Tags),
+		TTL:      pointer.FromInt64(input.TTL),
+	}
+
+	switch dns.RecordType(input.Type) {
+	case dns.A:
+		records := make([]dns.ARecord, 0)
+		for _, v := range input.ARecords {
+			records = append(records, dns.ARecord{Ipv4Address: pointer.FromString(v.IPv4Address)})
+		}
+		props.ARecords = &records
+
+	case dns.AAAA:
+		records := make([]dns.AaaaRecord, 0)
+		for _, v := range input.AaaaRecords {
+			records = append(records, dns.AaaaRecord{Ipv6Address: pointer.FromString(v.IPv6Address)})
+		}
+		props.AaaaRecords = &records
+
+	case dns.CNAME:
+		if len(input.CnameRecord) == 0 {
+			return nil, fmt.Errorf("a `cname_record` block must be specified when `type` is `CNAME`")
+		}
+		props.CnameRecord = &dns.CnameRecord{Cname: pointer.FromString(input.CnameRecord[0].Cname)}
+
+	case dns.MX:
+		records := make([]dns.MxRecord, 0)
+		for _, v := range input.MxRecords {
+			records = append(records, dns.MxRecord{
+				Preference: utils.Int32(int32(v.Preference)),
+				Exchange:   pointer.FromString(v.Exchange),
+			})
+		}
+		props.MxRecords = &records
+
+	case dns.NS:
+		records := make([]dns.NsRecord, 0)
+		for _, v := range input.NsRecords {
+			records = append(records, dns.NsRecord{Nsdname: pointer.FromString(v.Nsdname)})
+		}
+		props.NsRecords = &records
+
+	case dns.PTR:
+		records := make([]dns.PtrRecord, 0)
+		for _, v := range input.PtrRecords {
+			records = append(records, dns.PtrRecord{Ptrdname: pointer.FromString(v.Ptrdname)})
+		}
+		props.PtrRecords = &records
+
+	case dns.SOA:
+		if len(input.SoaRecord) == 0 {
+			return nil, fmt.Errorf("a `soa_record` block must be specified when `type` is `SOA`")
+		}
+		soa := input.SoaRecord[0]
+
+		if len(input.ZoneName+strings.TrimSuffix(soa.Email, ".")) > 253 {
+			return nil, fmt.Errorf("`email` which is concatenated with the DNS Zone name cannot exceed 253 characters excluding a trailing period")
+		}
+
+		host := soa.HostName
+		if existing != nil && existing.RecordSetProperties != nil && existing.RecordSetProperties.SoaRecord != nil && existing.RecordSetProperties.SoaRecord.Host != nil {
+			host = *existing.RecordSetProperties.SoaRecord.Host
+		}
+
+		props.SoaRecord = &dns.SoaRecord{
+			Email:        pointer.FromString(soa.Email),
+			Host:         pointer.FromString(host),
+			ExpireTime:   pointer.FromInt64(soa.ExpireTime),
+			MinimumTTL:   pointer.FromInt64(soa.MinimumTTL),
+			RefreshTime:  pointer.FromInt64(soa.RefreshTime),
+			RetryTime:    pointer.FromInt64(soa.RetryTime),
+			SerialNumber: pointer.FromInt64(soa.SerialNumber),
+		}
+
+	case dns.SRV:
+		records := make([]dns.SrvRecord, 0)
+		for _, v := range input.SrvRecords {
+			records = append(records, dns.SrvRecord{
+				Priority: utils.Int32(int32(v.Priority)),
+				Weight:   utils.Int32(int32(v.Weight)),
+				Port:     utils.Int32(int32(v.Port)),
+				Target:   pointer.FromString(v.Target),
+			})
+		}
+		props.SrvRecords = &records
+
+	case dns.TXT:
+		segmentLen := 254
+		records := make([]dns.TxtRecord, 0)
+		for _, v := range input.TxtRecords {
+			value := v.Value
+
+			var segments []string
+			for len(value) > segmentLen {
+				segments = append(segments, value[:segmentLen])
+				value = value[segmentLen:]
+			}
+			segments = append(segments, value)
+
+			records = append(records, dns.TxtRecord{Value: &segments})
+		}
+		props.TxtRecords = &records
+
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", input.Type)
+	}
+
+	return &dns.RecordSet{
+		Name:                pointer.FromString(input.Name),
+		RecordSetProperties: &props,
+	}, nil
+}
+
+func flattenDnsRecordSetModel(id parse.RecordSetId, input dns.RecordSet) DnsRecordSetModel {
+	output := DnsRecordSetModel{
+		Name:              id.Name,
+		ResourceGroupName: id.ResourceGroup,
+		ZoneName:          id.DnszoneName,
+		Type:              id.RecordType,
+		ARecords:          make([]DnsRecordSetARecord, 0),
+		AaaaRecords:       make([]DnsRecordSetAaaaRecord, 0),
+		CnameRecord:       make([]DnsRecordSetCnameRecord, 0),
+		MxRecords:         make([]DnsRecordSetMxRecord, 0),
+		NsRecords:         make([]DnsRecordSetNsRecord, 0),
+		PtrRecords:        make([]DnsRecordSetPtrRecord, 0),
+		SoaRecord:         make([]DnsRecordSetSoaRecord, 0),
+		SrvRecords:        make([]DnsRecordSetSrvRecord, 0),
+		TxtRecords:        make([]DnsRecordSetTxtRecord, 0),
+	}
+
+	props := input.RecordSetProperties
+	if props == nil {
+		return output
+	}
+
+	output.Tags = tags.ToTypedObject(props.Metadata)
+	output.Fqdn = pointer.ToString(props.Fqdn)
+	output.Etag = pointer.ToString(input.Etag)
+	output.TTL = pointer.ToInt64(props.TTL)
+
+	if props.ARecords != nil {
+		for _, v := range *props.ARecords {
+			output.ARecords = append(output.ARecords, DnsRecordSetARecord{IPv4Address: pointer.ToString(v.Ipv4Address)})
+		}
+	}
+
+	if props.AaaaRecords != nil {
+		for _, v := range *props.AaaaRecords {
+			output.AaaaRecords = append(output.AaaaRecords, DnsRecordSetAaaaRecord{IPv6Address: pointer.ToString(v.Ipv6Address)})
+		}
+	}
+
+	if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+		output.CnameRecord = append(output.CnameRecord, DnsRecordSetCnameRecord{Cname: *props.CnameRecord.Cname})
+	}
+
+	if props.MxRecords != nil {
+		for _, v := range *props.MxRecords {
+			output.MxRecords = append(output.MxRecords, DnsRecordSetMxRecord{
+				Preference: int64(utils.NormaliseNilableInt32(v.Preference)),
+				Exchange:   pointer.ToString(v.Exchange),
+			})
+		}
+	}
+
+	if props.NsRecords != nil {
+		for _, v := range *props.NsRecords {
+			output.NsRecords = append(output.NsRecords, DnsRecordSetNsRecord{Nsdname: pointer.ToString(v.Nsdname)})
+		}
+	}
+
+	if props.PtrRecords != nil {
+		for _, v := range *props.PtrRecords {
+			output.PtrRecords = append(output.PtrRecords, DnsRecordSetPtrRecord{Ptrdname: pointer.ToString(v.Ptrdname)})
+		}
+	}
+
+	if soa := props.SoaRecord; soa != nil {
+		output.SoaRecord = append(output.SoaRecord, DnsRecordSetSoaRecord{
+			Email:        pointer.ToString(soa.Email),
+			HostName:     pointer.ToString(soa.Host),
+			ExpireTime:   pointer.ToInt64(soa.ExpireTime),
+			MinimumTTL:   pointer.ToInt64(soa.MinimumTTL),
+			RefreshTime:  pointer.ToInt64(soa.RefreshTime),
+			RetryTime:    pointer.ToInt64(soa.RetryTime),
+			SerialNumber: pointer.ToInt64(soa.SerialNumber),
+		})
+	}
+
+	if props.SrvRecords != nil {
+		for _, v := range *props.SrvRecords {
+			output.SrvRecords = append(output.SrvRecords, DnsRecordSetSrvRecord{
+				Priority: int64(utils.NormaliseNilableInt32(v.Priority)),
+				Weight:   int64(utils.NormaliseNilableInt32(v.Weight)),
+				Port:     int64(utils.NormaliseNilableInt32(v.Port)),
+				Target:   pointer.ToString(v.Target),
+			})
+		}
+	}
+
+	if props.TxtRecords != nil {
+		for _, v := range *props.TxtRecords {
+			if v.Value != nil {
+				output.TxtRecords = append(output.TxtRecords, DnsRecordSetTxtRecord{Value: strings.Join(*v.Value, "")})
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type DnsRecordSetResource struct{}
+
+func TestAccDnsRecordSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDnsRecordSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("a_record.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("a_record.#").HasValue("3"),
+				check.That(data.ResourceName).Key("ttl").HasValue("600"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSet_mx(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.mx(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mx_record.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSet_srv(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.srv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSet_txt(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.txt(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSet_soa(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.soa(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soa_record.0.host_name").Exists(),
+				check.That(data.ResourceName).Key("soa_record.0.refresh_time").HasValue("1800"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsRecordSet_withETags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_record_set", "test")
+	r := DnsRecordSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withETags(data, 300),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("etag").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withETags(data, 600),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ttl").HasValue("600"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsRecordSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RecordSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.DnszoneName, id.Name, dns.RecordType(id.RecordType))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.RecordSetProperties != nil), nil
+}
+
+func (DnsRecordSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DnsRecordSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "myarecord%d"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "A"
+  ttl                 = 300
+
+  a_record {
+    ipv4_address = "1.2.3.4"
+  }
+
+  a_record {
+    ipv4_address = "1.2.4.5"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (DnsRecordSetResource) withETags(data acceptance.TestData, ttl int) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    dns {
+      use_etags = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "myarecord%[1]d"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "A"
+  ttl                 = %[3]d
+
+  a_record {
+    ipv4_address = "1.2.3.4"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, ttl)
+}
+
+func (r DnsRecordSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "import" {
+  name                = azurestack_dns_record_set.test.name
+  resource_group_name = azurestack_dns_record_set.test.resource_group_name
+  zone_name           = azurestack_dns_record_set.test.zone_name
+  type                = azurestack_dns_record_set.test.type
+  ttl                 = 300
+
+  a_record {
+    ipv4_address = "1.2.3.4"
+  }
+
+  a_record {
+    ipv4_address = "1.2.4.5"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DnsRecordSetResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "myarecord%d"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "A"
+  ttl                 = 600
+
+  a_record {
+    ipv4_address = "1.2.3.4"
+  }
+
+  a_record {
+    ipv4_address = "1.2.4.5"
+  }
+
+  a_record {
+    ipv4_address = "1.2.3.7"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DnsRecordSetResource) mx(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "@"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "MX"
+  ttl                 = 300
+
+  mx_record {
+    preference = 10
+    exchange   = "mail1.contoso.com"
+  }
+
+  mx_record {
+    preference = 20
+    exchange   = "mail2.contoso.com"
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsRecordSetResource) srv(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "_sip._tcp"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "SRV"
+  ttl                 = 300
+
+  srv_record {
+    priority = 1
+    weight   = 5
+    port     = 5060
+    target   = "sip1.contoso.com"
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsRecordSetResource) txt(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "mytxtrecord%d"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "TXT"
+  ttl                 = 300
+
+  txt_record {
+    value = "Quick brown fox"
+  }
+
+  txt_record {
+    value = "%s"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomStringOfLength(300))
+}
+
+func (r DnsRecordSetResource) soa(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_record_set" "test" {
+  name                = "@"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  type                = "SOA"
+  ttl                 = 3600
+
+  soa_record {
+    email        = "testemail.com"
+    refresh_time = 1800
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func dnsRecordsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dnsRecordsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"record_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dns.A),
+					string(dns.AAAA),
+					string(dns.CNAME),
+					string(dns.MX),
+					string(dns.NS),
+					string(dns.PTR),
+					string(dns.SOA),
+					string(dns.SRV),
+					string(dns.TXT),
+				}, false),
+			},
+
+			"name_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"record_sets": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"tags": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dnsRecordsDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId := parse.NewDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+	recordType := d.Get("record_type").(string)
+	namePrefix := d.Get("name_prefix").(string)
+
+	var iterator dns.RecordSetListResultIterator
+	var err error
+	if recordType != "" {
+		iterator, err = client.ListByTypeComplete(ctx, zoneId.ResourceGroup, zoneId.Name, dns.RecordType(recordType), nil, "")
+	} else {
+		iterator, err = client.ListByDNSZoneComplete(ctx, zoneId.ResourceGroup, zoneId.Name, nil, "")
+	}
+	if err != nil {
+		return fmt.Errorf("listing Record Sets within %s: %+v", zoneId, err)
+	}
+
+	recordSets := make([]interface{}, 0)
+	for iterator.NotDone() {
+		recordSet := iterator.Value()
+		// DNS names are case-insensitive, so the prefix is too
+		if recordSet.Name != nil && strings.HasPrefix(strings.ToLower(*recordSet.Name), strings.ToLower(namePrefix)) {
+			recordSets = append(recordSets, flattenDnsRecordSet(recordSet))
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Record Sets within %s: %+v", zoneId, err)
+		}
+	}
+
+	d.SetId(zoneId.ID())
+
+	if err := d.Set("record_sets", recordSets); err != nil {
+		return fmt.Errorf("setting `record_sets`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDnsRecordSet(input dns.RecordSet) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":      "",
+		"name":    "",
+		"type":    "",
+		"fqdn":    "",
+		"ttl":     0,
+		"records": []interface{}{},
+		"tags":    map[string]interface{}{},
+	}
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+	if input.Type != nil {
+		// the type is returned as `Microsoft.Network/dnszones/A`
+		output["type"] = (*input.Type)[strings.LastIndex(*input.Type, "/")+1:]
+	}
+
+	if props := input.RecordSetProperties; props != nil {
+		if props.Fqdn != nil {
+			output["fqdn"] = *props.Fqdn
+		}
+		if props.TTL != nil {
+			output["ttl"] = int(*props.TTL)
+		}
+
+		tags := make(map[string]interface{})
+		for k, v := range props.Metadata {
+			if v != nil {
+				tags[k] = *v
+			}
+		}
+		output["tags"] = tags
+
+		output["records"] = flattenDnsRecordSetValues(props)
+	}
+
+	return output
+}
+
+// flattenDnsRecordSetValues returns the values within a Record Set in zone file presentation format, regardless of type
+func flattenDnsRecordSetValues(props *dns.RecordSetProperties) []interface{} {
+	values := make([]interface{}, 0)
+
+	if props.ARecords != nil {
+		for _, v := range *props.ARecords {
+			if v.Ipv4Address != nil {
+				values = append(values, *v.Ipv4Address)
+			}
+		}
+	}
+	if props.AaaaRecords != nil {
+		for _, v := range *props.AaaaRecords {
+			if v.Ipv6Address != nil {
+				values = append(values, *v.Ipv6Address)
+			}
+		}
+	}
+	if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+		values = append(values, *props.CnameRecord.Cname)
+	}
+	if props.MxRecords != nil {
+		for _, v := range *props.MxRecords {
+			if v.Preference != nil && v.Exchange != nil {
+				values = append(values, fmt.Sprintf("%d %s", *v.Preference, *v.Exchange))
+			}
+		}
+	}
+	if props.NsRecords != nil {
+		for _, v := range *props.NsRecords {
+			if v.Nsdname != nil {
+				values = append(values, *v.Nsdname)
+			}
+		}
+	}
+	if props.PtrRecords != nil {
+		for _, v := range *props.PtrRecords {
+			if v.Ptrdname != nil {
+				values = append(values, *v.Ptrdname)
+			}
+		}
+	}
+	if props.SrvRecords != nil {
+		for _, v := range *props.SrvRecords {
+			if v.Priority != nil && v.Weight != nil && v.Port != nil && v.Target != nil {
+				values = append(values, fmt.Sprintf("%d %d %d %s", *v.Priority, *v.Weight, *v.Port, *v.Target))
+			}
+		}
+	}
+	if props.TxtRecords != nil {
+		for _, v := range *props.TxtRecords {
+			if v.Value != nil {
+				values = append(values, strings.Join(*v.Value, ""))
+			}
+		}
+	}
+	if soa := props.SoaRecord; soa != nil {
+		fields := []string{
+			stringOrEmpty(soa.Host),
+			stringOrEmpty(soa.Email),
+			int64OrEmpty(soa.SerialNumber),
+			int64OrEmpty(soa.RefreshTime),
+			int64OrEmpty(soa.RetryTime),
+			int64OrEmpty(soa.ExpireTime),
+			int64OrEmpty(soa.MinimumTTL),
+		}
+		values = append(values, strings.Join(fields, " "))
+	}
+
+	return values
+}
+
+func stringOrEmpty(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
+
+func int64OrEmpty(input *int64) string {
+	if input == nil {
+		return ""
+	}
+	return strconv.FormatInt(*input, 10)
+}
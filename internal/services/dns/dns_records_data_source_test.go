@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type DnsRecordsDataSource struct{}
+
+func TestAccDnsRecordsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				// the zone's NS and SOA records, plus the two records below
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("4"),
+			),
+		},
+	})
+}
+
+func TestAccDnsRecordsDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("1"),
+				check.That(data.ResourceName).Key("record_sets.0.name").HasValue("web"),
+				check.That(data.ResourceName).Key("record_sets.0.type").HasValue("A"),
+				check.That(data.ResourceName).Key("record_sets.0.ttl").HasValue("300"),
+				check.That(data.ResourceName).Key("record_sets.0.records.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (DnsRecordsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_dns_a_record" "test" {
+  name                = "web"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+
+resource "azurestack_dns_a_record" "other" {
+  name                = "other"
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  ttl                 = 300
+  records             = ["1.2.3.6"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DnsRecordsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_dns_records" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+
+  depends_on = [azurestack_dns_a_record.test, azurestack_dns_a_record.other]
+}
+`, r.template(data))
+}
+
+func (r DnsRecordsDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_dns_records" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  record_type         = "A"
+  name_prefix         = "WE" # matched case-insensitively
+
+  depends_on = [azurestack_dns_a_record.test, azurestack_dns_a_record.other]
+}
+`, r.template(data))
+}
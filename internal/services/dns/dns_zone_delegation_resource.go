@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func dnsZoneDelegation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: dnsZoneDelegationCreate,
+		Read:   dnsZoneDelegationRead,
+		Update: dnsZoneDelegationUpdate,
+		Delete: dnsZoneDelegationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, _, err := parseDnsZoneDelegationID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dnsZoneDelegationCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"parent_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsZoneID,
+			},
+
+			"child_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsZoneID,
+			},
+
+			"ttl": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"name_servers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dnsZoneDelegationCustomizeDiff looks up the current Name Servers of the child zone so that
+// any change to them shows up in the plan and is pushed to the parent zone on apply.
+func dnsZoneDelegationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("child_zone_id") {
+		return nil
+	}
+
+	childId, err := parse.DnsZoneID(d.Get("child_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	nameServers, err := dnsZoneDelegationChildNameServers(ctx, meta.(*clients.Client).Dns.ZonesClient, *childId)
+	if err != nil {
+		// the child zone may be being replaced or removed, in which case there's nothing to compare
+		log.Printf("[DEBUG] unable to retrieve the Name Servers for %s - skipping drift detection: %+v", *childId, err)
+		return nil
+	}
+
+	existing := make([]string, 0)
+	for _, v := range d.Get("name_servers").([]interface{}) {
+		existing = append(existing, v.(string))
+	}
+
+	if dnsZoneDelegationNameServersEqual(existing, nameServers) {
+		return nil
+	}
+
+	return d.SetNew("name_servers", nameServers)
+}
+
+func dnsZoneDelegationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := parse.DnsZoneID(d.Get("parent_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	childId, err := parse.DnsZoneID(d.Get("child_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name, err := dnsZoneDelegationRecordName(*parentId, *childId)
+	if err != nil {
+		return err
+	}
+
+	nsId := parse.NewNsRecordID(parentId.SubscriptionId, parentId.ResourceGroup, parentId.Name, name)
+	resourceId := fmt.Sprintf("%s|%s", nsId.ID(), childId.ID())
+
+	existing, err := client.Get(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", nsId, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurestack_dns_zone_delegation", resourceId)
+	}
+
+	nameServers, err := dnsZoneDelegationChildNameServers(ctx, zonesClient, *childId)
+	if err != nil {
+		return err
+	}
+
+	parameters := dns.RecordSet{
+		Name: pointer.FromString(name),
+		RecordSetProperties: &dns.RecordSetProperties{
+			TTL:       pointer.FromInt64(int64(d.Get("ttl").(int))),
+			NsRecords: expandDnsZoneDelegationNameServers(nameServers),
+		},
+	}
+
+	// `If-None-Match: *` ensures we don't overwrite a Record Set created by another writer since the check above
+	resp, err := client.CreateOrUpdate(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS, parameters, "", "*")
+	if err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			return tf.ImportAsExistsError("azurestack_dns_zone_delegation", resourceId)
+		}
+		return fmt.Errorf("creating %s: %+v", nsId, err)
+	}
+
+	d.SetId(resourceId)
+
+	return dnsZoneDelegationRead(d, meta)
+}
+
+func dnsZoneDelegationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nsId, childId, err := parseDnsZoneDelegationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *nsId, err)
+	}
+
+	if existing.RecordSetProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *nsId)
+	}
+
+	nameServers, err := dnsZoneDelegationChildNameServers(ctx, zonesClient, *childId)
+	if err != nil {
+		return err
+	}
+
+	existing.RecordSetProperties.NsRecords = expandDnsZoneDelegationNameServers(nameServers)
+	existing.RecordSetProperties.TTL = pointer.FromInt64(int64(d.Get("ttl").(int)))
+
+	// `If-Match` ensures we only update the Record Set we've just read, rather than overwriting changes made since
+	resp, err := client.CreateOrUpdate(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS, existing, pointer.ToString(existing.Etag), "")
+	if err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp.Response) {
+			return fmt.Errorf("updating %s: the Record Set was modified by another writer, please re-run `terraform plan` and try again", *nsId)
+		}
+		return fmt.Errorf("updating %s: %+v", *nsId, err)
+	}
+
+	return dnsZoneDelegationRead(d, meta)
+}
+
+func dnsZoneDelegationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nsId, childId, err := parseDnsZoneDelegationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *nsId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *nsId, err)
+	}
+
+	d.Set("parent_zone_id", parse.NewDnsZoneID(nsId.SubscriptionId, nsId.ResourceGroup, nsId.DnszoneName).ID())
+	d.Set("child_zone_id", childId.ID())
+	d.Set("name", nsId.NSName)
+	d.Set("fqdn", resp.Fqdn)
+
+	if props := resp.RecordSetProperties; props != nil {
+		d.Set("ttl", props.TTL)
+
+		if err := d.Set("name_servers", flattenazurestackDnsNsRecords(props.NsRecords)); err != nil {
+			return fmt.Errorf("setting `name_servers`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func dnsZoneDelegationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nsId, _, err := parseDnsZoneDelegationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *nsId, err)
+	}
+
+	resp, err := client.Delete(ctx, nsId.ResourceGroup, nsId.DnszoneName, nsId.NSName, dns.NS, pointer.ToString(existing.Etag))
+	if err != nil {
+		if dnsRecordSetResponseWasPreconditionFailed(resp) {
+			return fmt.Errorf("deleting %s: the Record Set was modified by another writer, please re-run `terraform plan` and try again", *nsId)
+		}
+		return fmt.Errorf("deleting %s: %+v", *nsId, err)
+	}
+
+	return nil
+}
+
+func parseDnsZoneDelegationID(input string) (*parse.NsRecordId, *parse.DnsZoneId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, nil, fmt.Errorf("expected ID to be in the format {parentNsRecordId}|{childZoneId} but got %q", input)
+	}
+
+	nsId, err := parse.NsRecordID(splitId[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	childId, err := parse.DnsZoneID(splitId[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nsId, childId, nil
+}
+
+// dnsZoneDelegationRecordName returns the name of the NS Record Set within the parent zone which
+// delegates to the child zone, e.g. `internal` for `internal.contoso.local` within `contoso.local`.
+func dnsZoneDelegationRecordName(parentId, childId parse.DnsZoneId) (string, error) {
+	parent := strings.ToLower(strings.TrimSuffix(parentId.Name, "."))
+	child := strings.ToLower(strings.TrimSuffix(childId.Name, "."))
+
+	if !strings.HasSuffix(child, "."+parent) {
+		return "", fmt.Errorf("the child zone %q must be a subdomain of the parent zone %q", childId.Name, parentId.Name)
+	}
+
+	return strings.TrimSuffix(child, "."+parent), nil
+}
+
+func dnsZoneDelegationChildNameServers(ctx context.Context, client *dns.ZonesClient, id parse.DnsZoneId) ([]string, error) {
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.ZoneProperties == nil || resp.ZoneProperties.NameServers == nil || len(*resp.ZoneProperties.NameServers) == 0 {
+		return nil, fmt.Errorf("retrieving %s: no Name Servers were returned", id)
+	}
+
+	return *resp.ZoneProperties.NameServers, nil
+}
+
+func dnsZoneDelegationNameServersEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	normalise := func(input []string) []string {
+		output := make([]string, 0, len(input))
+		for _, v := range input {
+			output = append(output, strings.ToLower(strings.TrimSuffix(v, ".")))
+		}
+		sort.Strings(output)
+		return output
+	}
+
+	x, y := normalise(a), normalise(b)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandDnsZoneDelegationNameServers(input []string) *[]dns.NsRecord {
+	records := make([]dns.NsRecord, 0, len(input))
+	for _, v := range input {
+		records = append(records, dns.NsRecord{
+			Nsdname: pointer.FromString(v),
+		})
+	}
+	return &records
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type DnsZoneDelegationResource struct{}
+
+func TestAccDnsZoneDelegation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone_delegation", "test")
+	r := DnsZoneDelegationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").HasValue("internal"),
+				check.That(data.ResourceName).Key("ttl").HasValue("3600"),
+				check.That(data.ResourceName).Key("name_servers.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsZoneDelegation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone_delegation", "test")
+	r := DnsZoneDelegationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDnsZoneDelegation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone_delegation", "test")
+	r := DnsZoneDelegationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.ttl(data, 300),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ttl").HasValue("300"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsZoneDelegation_driftedNameServers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone_delegation", "test")
+	r := DnsZoneDelegationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.overwriteNameServers("ns1.example.com.")),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			// the next apply should put the child zone's Name Servers back
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.nameServersMatchChildZone),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsZoneDelegationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NsRecordID(strings.Split(state.ID, "|")[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.RecordSetProperties != nil), nil
+}
+
+// overwriteNameServers simulates another writer changing the delegation out from under Terraform
+func (DnsZoneDelegationResource) overwriteNameServers(nameServer string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.NsRecordID(strings.Split(state.ID, "|")[0])
+		if err != nil {
+			return err
+		}
+
+		existing, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		existing.RecordSetProperties.NsRecords = &[]dns.NsRecord{
+			{
+				Nsdname: pointer.FromString(nameServer),
+			},
+		}
+
+		if _, err := clients.Dns.RecordSetsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.DnszoneName, id.NSName, dns.NS, existing, "", ""); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+
+		return nil
+	}
+}
+
+func (DnsZoneDelegationResource) nameServersMatchChildZone(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	childId, err := parse.DnsZoneID(state.Attributes["child_zone_id"])
+	if err != nil {
+		return err
+	}
+
+	zone, err := clients.Dns.ZonesClient.Get(ctx, childId.ResourceGroup, childId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *childId, err)
+	}
+
+	if zone.ZoneProperties == nil || zone.ZoneProperties.NameServers == nil {
+		return fmt.Errorf("retrieving %s: `nameServers` was nil", *childId)
+	}
+
+	expected := *zone.ZoneProperties.NameServers
+	if actual := state.Attributes["name_servers.#"]; actual != fmt.Sprint(len(expected)) {
+		return fmt.Errorf("expected %d Name Servers but got %s", len(expected), actual)
+	}
+
+	for i, v := range expected {
+		if actual := state.Attributes[fmt.Sprintf("name_servers.%d", i)]; actual != v {
+			return fmt.Errorf("expected Name Server %d to be %q but got %q", i, v, actual)
+		}
+	}
+
+	return nil
+}
+
+func (DnsZoneDelegationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_dns_zone" "parent" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_dns_zone" "child" {
+  name                = "internal.acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DnsZoneDelegationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_zone_delegation" "test" {
+  parent_zone_id = azurestack_dns_zone.parent.id
+  child_zone_id  = azurestack_dns_zone.child.id
+}
+`, r.template(data))
+}
+
+func (r DnsZoneDelegationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_zone_delegation" "import" {
+  parent_zone_id = azurestack_dns_zone_delegation.test.parent_zone_id
+  child_zone_id  = azurestack_dns_zone_delegation.test.child_zone_id
+}
+`, r.basic(data))
+}
+
+func (r DnsZoneDelegationResource) ttl(data acceptance.TestData, ttl int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_zone_delegation" "test" {
+  parent_zone_id = azurestack_dns_zone.parent.id
+  child_zone_id  = azurestack_dns_zone.child.id
+  ttl            = %d
+}
+`, r.template(data), ttl)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func dnsZoneFileDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dnsZoneFileDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"content": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dnsZoneFileDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			return fmt.Errorf("Error: %s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	iterator, err := client.ListByDNSZoneComplete(ctx, id.ResourceGroup, id.Name, nil, "")
+	if err != nil {
+		return fmt.Errorf("listing Record Sets within %s: %+v", id, err)
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	for iterator.NotDone() {
+		recordSets = append(recordSets, flattenDnsZoneFileRecordSet(iterator.Value()))
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Record Sets within %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("content", zonefile.Serialize(id.Name, recordSets))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type DnsZoneFileDataSource struct{}
+
+func TestAccDnsZoneFileDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_dns_zone_file", "test")
+	r := DnsZoneFileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`(?m)^@\s+\d+\s+IN SOA\s`)),
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`(?m)^mail\s+300\s+IN A\s+10\.0\.0\.1$`)),
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`(?m)^www\s+300\s+IN CNAME\s+mail\.acctestzone\d+\.com\.$`)),
+			),
+		},
+	})
+}
+
+func (DnsZoneFileDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_dns_zone_file" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+
+  depends_on = [azurestack_dns_zone_file.test]
+}
+`, DnsZoneFileResource{}.basic(data))
+}
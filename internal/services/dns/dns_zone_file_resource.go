@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func dnsZoneFile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: dnsZoneFileCreateUpdate,
+		Read:   dnsZoneFileRead,
+		Update: dnsZoneFileCreateUpdate,
+		Delete: dnsZoneFileDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsZoneID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"content": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: dnsZoneFileContentDiffSuppress,
+			},
+
+			"default_ttl": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      int(zonefile.DefaultTTL),
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+
+			"record_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+		},
+	}
+}
+
+func dnsZoneFileCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+	defaultTTL := int64(d.Get("default_ttl").(int))
+
+	if d.IsNewResource() {
+		existing, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("%s was not found", id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	recordSets, err := parseDnsZoneFileContent(d.Get("content").(string), id.Name, defaultTTL)
+	if err != nil {
+		return fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	// Record Sets which were removed from the zone file are deleted before the remaining Record Sets are reconciled
+	if d.HasChange("content") && !d.IsNewResource() {
+		old, _ := d.GetChange("content")
+		oldRecordSets, err := parseDnsZoneFileContent(old.(string), id.Name, defaultTTL)
+		if err != nil {
+			return fmt.Errorf("parsing the previous `content`: %+v", err)
+		}
+
+		current := make(map[string]struct{})
+		for _, rs := range recordSets {
+			current[rs.Key()] = struct{}{}
+		}
+		for _, rs := range oldRecordSets {
+			if _, ok := current[rs.Key()]; ok {
+				continue
+			}
+			if err := deleteDnsZoneFileRecordSet(ctx, client, id, rs); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rs := range recordSets {
+		parameters, err := expandDnsZoneFileRecordSet(rs)
+		if err != nil {
+			return err
+		}
+
+		eTag := ""
+		ifNoneMatch := "" // set to empty to allow updates to records after creation
+		if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, rs.Name, dns.RecordType(rs.Type), *parameters, eTag, ifNoneMatch); err != nil {
+			return fmt.Errorf("creating/updating DNS %s Record %q (Zone %q / Resource Group %q): %s", rs.Type, rs.Name, id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return dnsZoneFileRead(d, meta)
+}
+
+func dnsZoneFileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	defaultTTL := zonefile.DefaultTTL
+	if v, ok := d.GetOk("default_ttl"); ok {
+		defaultTTL = int64(v.(int))
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	recordSetIds := make([]string, 0)
+
+	if content := d.Get("content").(string); content != "" {
+		managed, err := parseDnsZoneFileContent(content, id.Name, defaultTTL)
+		if err != nil {
+			return fmt.Errorf("parsing `content`: %+v", err)
+		}
+
+		for _, rs := range managed {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name, rs.Name, dns.RecordType(rs.Type))
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					log.Printf("[DEBUG] DNS %s Record %q was not found in %s", rs.Type, rs.Name, *id)
+					continue
+				}
+				return fmt.Errorf("retrieving DNS %s Record %q (Zone %q / Resource Group %q): %+v", rs.Type, rs.Name, id.Name, id.ResourceGroup, err)
+			}
+
+			recordSets = append(recordSets, flattenDnsZoneFileRecordSet(resp))
+			if resp.ID != nil {
+				recordSetIds = append(recordSetIds, *resp.ID)
+			}
+		}
+	} else {
+		// when importing all of the Record Sets within the zone are managed, other than those managed by Azure
+		iterator, err := client.ListByDNSZoneComplete(ctx, id.ResourceGroup, id.Name, nil, "")
+		if err != nil {
+			return fmt.Errorf("listing Record Sets within %s: %+v", *id, err)
+		}
+		for iterator.NotDone() {
+			resp := iterator.Value()
+			if rs := flattenDnsZoneFileRecordSet(resp); !rs.IsAzureManaged() {
+				recordSets = append(recordSets, rs)
+				if resp.ID != nil {
+					recordSetIds = append(recordSetIds, *resp.ID)
+				}
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Record Sets within %s: %+v", *id, err)
+			}
+		}
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("zone_name", id.Name)
+	d.Set("default_ttl", int(defaultTTL))
+	d.Set("content", zonefile.Serialize(id.Name, recordSets))
+
+	if err := d.Set("record_set_ids", recordSetIds); err != nil {
+		return fmt.Errorf("setting `record_set_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func dnsZoneFileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := parseDnsZoneFileContent(d.Get("content").(string), id.Name, int64(d.Get("default_ttl").(int)))
+	if err != nil {
+		return fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	for _, rs := range recordSets {
+		if err := deleteDnsZoneFileRecordSet(ctx, client, *id, rs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDnsZoneFileContent parses a zone file, omitting the Record Sets which Azure manages for each zone
+func parseDnsZoneFileContent(content, zoneName string, defaultTTL int64) ([]zonefile.RecordSet, error) {
+	recordSets, err := zonefile.Parse(content, zoneName, defaultTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]zonefile.RecordSet, 0)
+	for _, rs := range recordSets {
+		if rs.IsAzureManaged() {
+			log.Printf("[DEBUG] Ignoring the DNS %s Record %q within the zone file since this is managed by Azure", rs.Type, rs.Name)
+			continue
+		}
+		output = append(output, rs)
+	}
+
+	return output, nil
+}
+
+func dnsZoneFileContentDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	zoneName := d.Get("zone_name").(string)
+	defaultTTL := int64(d.Get("default_ttl").(int))
+
+	oldRecordSets, err := parseDnsZoneFileContent(old, zoneName, defaultTTL)
+	if err != nil {
+		return false
+	}
+	newRecordSets, err := parseDnsZoneFileContent(new, zoneName, defaultTTL)
+	if err != nil {
+		return false
+	}
+
+	return zonefile.Equivalent(oldRecordSets, newRecordSets)
+}
+
+func deleteDnsZoneFileRecordSet(ctx context.Context, client *dns.RecordSetsClient, id parse.DnsZoneId, rs zonefile.RecordSet) error {
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name, rs.Name, dns.RecordType(rs.Type), "")
+	if err != nil && !utils.ResponseWasNotFound(resp) {
+		return fmt.Errorf("deleting DNS %s Record %q (Zone %q / Resource Group %q): %+v", rs.Type, rs.Name, id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandDnsZoneFileRecordSet(input zonefile.RecordSet) (*dns.RecordSet, error) {
+	ttl := input.TTL
+	props := dns.RecordSetProperties{
+		TTL: &ttl,
+	}
+
+	invalid := func(value string) error {
+		return fmt.Errorf("the value %q is not valid for the DNS %s Record %q", value, input.Type, input.Name)
+	}
+
+	switch input.Type {
+	case zonefile.TypeA:
+		records := make([]dns.ARecord, 0)
+		for _, v := range input.Values {
+			records = append(records, dns.ARecord{Ipv4Address: utils.String(v)})
+		}
+		props.ARecords = &records
+
+	case zonefile.TypeAAAA:
+		records := make([]dns.AaaaRecord, 0)
+		for _, v := range input.Values {
+			records = append(records, dns.AaaaRecord{Ipv6Address: utils.String(v)})
+		}
+		props.AaaaRecords = &records
+
+	case zonefile.TypeCNAME:
+		if len(input.Values) != 1 {
+			return nil, fmt.Errorf("the DNS CNAME Record %q must contain a single value", input.Name)
+		}
+		props.CnameRecord = &dns.CnameRecord{Cname: utils.String(input.Values[0])}
+
+	case zonefile.TypeMX:
+		records := make([]dns.MxRecord, 0)
+		for _, v := range input.Values {
+			fields := strings.Fields(v)
+			if len(fields) != 2 {
+				return nil, invalid(v)
+			}
+			preference, err := strconv.ParseInt(fields[0], 10, 32)
+			if err != nil {
+				return nil, invalid(v)
+			}
+			records = append(records, dns.MxRecord{
+				Preference: utils.Int32(int32(preference)),
+				Exchange:   utils.String(fields[1]),
+			})
+		}
+		props.MxRecords = &records
+
+	case zonefile.TypeNS:
+		records := make([]dns.NsRecord, 0)
+		for _, v := range input.Values {
+			records = append(records, dns.NsRecord{Nsdname: utils.String(v)})
+		}
+		props.NsRecords = &records
+
+	case zonefile.TypePTR:
+		records := make([]dns.PtrRecord, 0)
+		for _, v := range input.Values {
+			records = append(records, dns.PtrRecord{Ptrdname: utils.String(v)})
+		}
+		props.PtrRecords = &records
+
+	case zonefile.TypeSRV:
+		records := make([]dns.SrvRecord, 0)
+		for _, v := range input.Values {
+			fields := strings.Fields(v)
+			if len(fields) != 4 {
+				return nil, invalid(v)
+			}
+			numbers := make([]int32, 3)
+			for i := range numbers {
+				n, err := strconv.ParseInt(fields[i], 10, 32)
+				if err != nil {
+					return nil, invalid(v)
+				}
+				numbers[i] = int32(n)
+			}
+			records = append(records, dns.SrvRecord{
+				Priority: utils.Int32(numbers[0]),
+				Weight:   utils.Int32(numbers[1]),
+				Port:     utils.Int32(numbers[2]),
+				Target:   utils.String(fields[3]),
+			})
+		}
+		props.SrvRecords = &records
+
+	case zonefile.TypeTXT:
+		segmentLen := 254
+		records := make([]dns.TxtRecord, 0)
+		for _, v := range input.Values {
+			var value []string
+			for len(v) > segmentLen {
+				value = append(value, v[:segmentLen])
+				v = v[segmentLen:]
+			}
+			value = append(value, v)
+			records = append(records, dns.TxtRecord{Value: &value})
+		}
+		props.TxtRecords = &records
+
+	default:
+		return nil, fmt.Errorf("the DNS %s Record %q cannot be managed through a zone file", input.Type, input.Name)
+	}
+
+	return &dns.RecordSet{
+		Name:                utils.String(input.Name),
+		RecordSetProperties: &props,
+	}, nil
+}
+
+func flattenDnsZoneFileRecordSet(input dns.RecordSet) zonefile.RecordSet {
+	output := zonefile.RecordSet{
+		Values: make([]string, 0),
+	}
+
+	if input.Name != nil {
+		output.Name = strings.ToLower(*input.Name)
+	}
+	if input.Type != nil {
+		// the type is returned as `Microsoft.Network/dnszones/A`
+		output.Type = (*input.Type)[strings.LastIndex(*input.Type, "/")+1:]
+	}
+
+	if props := input.RecordSetProperties; props != nil {
+		if props.TTL != nil {
+			output.TTL = *props.TTL
+		}
+
+		for _, v := range flattenDnsRecordSetValues(props) {
+			value := v.(string)
+			if output.Type != zonefile.TypeTXT {
+				// domain names are compared without the trailing period, which Azure returns as specified
+				fields := strings.Fields(value)
+				for i := range fields {
+					fields[i] = strings.TrimSuffix(fields[i], ".")
+				}
+				value = strings.Join(fields, " ")
+			}
+			output.Values = append(output.Values, value)
+		}
+	}
+
+	return output
+}
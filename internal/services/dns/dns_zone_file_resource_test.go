@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/dns/mgmt/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type DnsZoneFileResource struct{}
+
+func TestAccDnsZoneFile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone_file", "test")
+	r := DnsZoneFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set_ids.#").HasValue("7"),
+			),
+		},
+		data.ImportStep("default_ttl"),
+	})
+}
+
+func TestAccDnsZoneFile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone_file", "test")
+	r := DnsZoneFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set_ids.#").HasValue("7"),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set_ids.#").HasValue("2"),
+				data.CheckWithClient(r.recordSetRemoved("www", dns.CNAME)),
+			),
+		},
+		data.ImportStep("default_ttl"),
+	})
+}
+
+func (DnsZoneFileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSets, err := zonefile.Parse(state.Attributes["content"], id.Name, zonefile.DefaultTTL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `content`: %+v", err)
+	}
+
+	for _, rs := range recordSets {
+		resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.Name, rs.Name, dns.RecordType(rs.Type))
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return pointer.FromBool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving DNS %s Record %q within %s: %+v", rs.Type, rs.Name, *id, err)
+		}
+	}
+
+	return pointer.FromBool(true), nil
+}
+
+func (DnsZoneFileResource) recordSetRemoved(name string, recordType dns.RecordType) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.DnsZoneID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.Dns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.Name, name, recordType)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return fmt.Errorf("retrieving DNS %s Record %q within %s: %+v", recordType, name, *id, err)
+		}
+
+		return fmt.Errorf("DNS %s Record %q still exists within %s", recordType, name, *id)
+	}
+}
+
+func (DnsZoneFileResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DnsZoneFileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_zone_file" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+
+  content = <<ZONE
+$TTL 300
+@          IN SOA  ns1.example.com. hostmaster.example.com. ( 1 3600 300 2419200 300 )
+@          IN NS   ns1.example.com.
+@          IN MX   10 mail
+@          IN TXT  "v=spf1 mx -all"
+mail       IN A    10.0.0.1
+           IN A    10.0.0.2
+ipv6       IN AAAA 2001:db8::1
+www        IN CNAME mail
+_sip._tcp  IN SRV  1 5 5060 sip.example.net.
+10         IN PTR  mail
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneFileResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_dns_zone_file" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  zone_name           = azurestack_dns_zone.test.name
+  default_ttl         = 600
+
+  content = <<ZONE
+@    IN MX 20 mail
+mail IN A  10.0.0.3
+ZONE
+}
+`, r.template(data))
+}
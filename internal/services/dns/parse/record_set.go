@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// recordSetTypes are the record types supported by the DNS API, which are used as the type segment of a Record Set ID
+var recordSetTypes = []string{"A", "AAAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"}
+
+// RecordSetId is the ID of a DNS Record Set of any type, which is compatible with the IDs of the per-type
+// record resources (e.g. `ARecordId`)
+type RecordSetId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnszoneName    string
+	RecordType     string
+	Name           string
+}
+
+func NewRecordSetID(subscriptionId, resourceGroup, dnszoneName, recordType, name string) RecordSetId {
+	return RecordSetId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnszoneName:    dnszoneName,
+		RecordType:     recordType,
+		Name:           name,
+	}
+}
+
+func (id RecordSetId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Record Type %q", id.RecordType),
+		fmt.Sprintf("Dnszone Name %q", id.DnszoneName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Record Set", segmentsStr)
+}
+
+func (id RecordSetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/%s/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.RecordType, id.Name)
+}
+
+// RecordSetID parses a RecordSet ID into an RecordSetId struct
+func RecordSetID(input string) (*RecordSetId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RecordSetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, err
+	}
+
+	for _, recordType := range recordSetTypes {
+		if _, ok := id.Path[recordType]; !ok {
+			continue
+		}
+		if resourceId.RecordType != "" {
+			return nil, fmt.Errorf("ID contained more than one record type element")
+		}
+		resourceId.RecordType = recordType
+		if resourceId.Name, err = id.PopSegment(recordType); err != nil {
+			return nil, err
+		}
+	}
+	if resourceId.RecordType == "" {
+		return nil, fmt.Errorf("ID was missing a record type element, expected one of %s", strings.Join(recordSetTypes, ", "))
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -30,22 +30,34 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_dns_zone": dnsZoneDataSource(),
+		"azurestack_dns_a_record":     dnsARecordDataSource(),
+		"azurestack_dns_aaaa_record":  dnsAAAARecordDataSource(),
+		"azurestack_dns_cname_record": dnsCNameRecordDataSource(),
+		"azurestack_dns_mx_record":    dnsMxRecordDataSource(),
+		"azurestack_dns_ns_record":    dnsNsRecordDataSource(),
+		"azurestack_dns_ptr_record":   dnsPtrRecordDataSource(),
+		"azurestack_dns_records":      dnsRecordsDataSource(),
+		"azurestack_dns_srv_record":   dnsSrvRecordDataSource(),
+		"azurestack_dns_txt_record":   dnsTxtRecordDataSource(),
+		"azurestack_dns_zone":         dnsZoneDataSource(),
+		"azurestack_dns_zone_file":    dnsZoneFileDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_dns_a_record":     dnsARecord(),
-		"azurestack_dns_aaaa_record":  dnsAAAARecord(),
-		"azurestack_dns_cname_record": dnsCNameRecord(),
-		"azurestack_dns_mx_record":    dnsMxRecord(),
-		"azurestack_dns_ns_record":    dnsNsRecord(),
-		"azurestack_dns_ptr_record":   dnsPtrRecord(),
-		"azurestack_dns_srv_record":   dnsSrvRecord(),
-		"azurestack_dns_txt_record":   dnsTxtRecord(),
-		"azurestack_dns_zone":         dnsZone(),
+		"azurestack_dns_a_record":        dnsARecord(),
+		"azurestack_dns_aaaa_record":     dnsAAAARecord(),
+		"azurestack_dns_cname_record":    dnsCNameRecord(),
+		"azurestack_dns_mx_record":       dnsMxRecord(),
+		"azurestack_dns_ns_record":       dnsNsRecord(),
+		"azurestack_dns_ptr_record":      dnsPtrRecord(),
+		"azurestack_dns_srv_record":      dnsSrvRecord(),
+		"azurestack_dns_txt_record":      dnsTxtRecord(),
+		"azurestack_dns_zone":            dnsZone(),
+		"azurestack_dns_zone_delegation": dnsZoneDelegation(),
+		"azurestack_dns_zone_file":       dnsZoneFile(),
 	}
 }
 
@@ -56,5 +68,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		DnsRecordSetResource{},
+	}
 }
@@ -0,0 +1,473 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+// entry is a single logical line within a zone file, which may span several physical lines when using parentheses
+type entry struct {
+	line          int
+	tokens        []token
+	inheritsOwner bool
+}
+
+// Parse parses the contents of a BIND zone file for the zone `zoneName` into Record Sets.
+//
+// Records which don't specify a TTL use the value of the most recent `$TTL` directive, falling back to `defaultTTL`.
+// Since Azure supports a single TTL per Record Set, the TTL of the first record within each Record Set is used.
+func Parse(input string, zoneName string, defaultTTL int64) ([]RecordSet, error) {
+	zone := strings.ToLower(strings.TrimSuffix(zoneName, "."))
+	if zone == "" {
+		return nil, fmt.Errorf("the zone name cannot be empty")
+	}
+
+	entries, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{
+		zone:       zone,
+		origin:     zone,
+		defaultTTL: defaultTTL,
+		sets:       make(map[string]*RecordSet),
+	}
+
+	for _, e := range entries {
+		if err := p.parseEntry(e); err != nil {
+			return nil, fmt.Errorf("line %d: %+v", e.line, err)
+		}
+	}
+
+	output := make([]RecordSet, 0, len(p.order))
+	for _, key := range p.order {
+		output = append(output, *p.sets[key])
+	}
+
+	return output, nil
+}
+
+type parser struct {
+	zone       string
+	origin     string
+	defaultTTL int64
+	lastOwner  string
+
+	sets  map[string]*RecordSet
+	order []string
+}
+
+func (p *parser) parseEntry(e entry) error {
+	tokens := e.tokens
+
+	if first := tokens[0]; !first.quoted && !e.inheritsOwner && strings.HasPrefix(first.value, "$") {
+		return p.parseDirective(tokens)
+	}
+
+	var owner string
+	if e.inheritsOwner {
+		if p.lastOwner == "" {
+			return fmt.Errorf("a record without an owner name must follow another record")
+		}
+		owner = p.lastOwner
+	} else {
+		name, err := p.relativeName(tokens[0].value)
+		if err != nil {
+			return err
+		}
+		owner = name
+		tokens = tokens[1:]
+	}
+	p.lastOwner = owner
+
+	ttl := int64(-1)
+	classSeen := false
+	for len(tokens) > 0 && !tokens[0].quoted {
+		value := tokens[0].value
+		if !classSeen && strings.EqualFold(value, "IN") {
+			classSeen = true
+			tokens = tokens[1:]
+			continue
+		}
+		if !classSeen && (strings.EqualFold(value, "CH") || strings.EqualFold(value, "HS") || strings.EqualFold(value, "CS")) {
+			return fmt.Errorf("only records within the `IN` class are supported but got %q", value)
+		}
+		if ttl == -1 {
+			if v, err := parseTTL(value); err == nil {
+				ttl = v
+				tokens = tokens[1:]
+				continue
+			}
+		}
+		break
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("expected a record type for %q", owner)
+	}
+	if ttl == -1 {
+		ttl = p.defaultTTL
+	}
+
+	recordType := strings.ToUpper(tokens[0].value)
+	value, err := p.parseRecordData(recordType, tokens[1:])
+	if err != nil {
+		return fmt.Errorf("parsing %s record %q: %+v", recordType, owner, err)
+	}
+
+	rs := RecordSet{
+		Name:   owner,
+		Type:   recordType,
+		TTL:    ttl,
+		Values: []string{value},
+	}
+	key := rs.Key()
+	existing, ok := p.sets[key]
+	if !ok {
+		p.sets[key] = &rs
+		p.order = append(p.order, key)
+		return nil
+	}
+
+	if recordType == TypeCNAME || recordType == TypeSOA {
+		return fmt.Errorf("only a single %s record can exist for %q", recordType, owner)
+	}
+	for _, v := range existing.Values {
+		if v == value {
+			return nil
+		}
+	}
+	existing.Values = append(existing.Values, value)
+
+	return nil
+}
+
+func (p *parser) parseDirective(tokens []token) error {
+	directive := strings.ToUpper(tokens[0].value)
+	switch directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("expected a single domain name for `$ORIGIN`")
+		}
+		origin, err := p.absoluteName(tokens[1].value)
+		if err != nil {
+			return err
+		}
+		if origin != p.zone && !strings.HasSuffix(origin, "."+p.zone) {
+			return fmt.Errorf("`$ORIGIN` %q is outside of the zone %q", tokens[1].value, p.zone)
+		}
+		p.origin = origin
+		return nil
+
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("expected a single value for `$TTL`")
+		}
+		ttl, err := parseTTL(tokens[1].value)
+		if err != nil {
+			return err
+		}
+		p.defaultTTL = ttl
+		return nil
+	}
+
+	return fmt.Errorf("the directive %q is not supported", tokens[0].value)
+}
+
+func (p *parser) parseRecordData(recordType string, tokens []token) (string, error) {
+	switch recordType {
+	case TypeA, TypeAAAA:
+		if err := expectFields(tokens, 1); err != nil {
+			return "", err
+		}
+		value := tokens[0].value
+		ip := net.ParseIP(value)
+		isIPv6 := strings.Contains(value, ":")
+		if recordType == TypeA && (ip == nil || isIPv6) {
+			return "", fmt.Errorf("%q is not a valid IPv4 address", value)
+		}
+		if recordType == TypeAAAA && (ip == nil || !isIPv6) {
+			return "", fmt.Errorf("%q is not a valid IPv6 address", value)
+		}
+		return value, nil
+
+	case TypeCNAME, TypeNS, TypePTR:
+		if err := expectFields(tokens, 1); err != nil {
+			return "", err
+		}
+		return p.absoluteName(tokens[0].value)
+
+	case TypeMX:
+		if err := expectFields(tokens, 2); err != nil {
+			return "", err
+		}
+		preference, err := parseUint16("preference", tokens[0].value)
+		if err != nil {
+			return "", err
+		}
+		exchange, err := p.absoluteName(tokens[1].value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d %s", preference, exchange), nil
+
+	case TypeSRV:
+		if err := expectFields(tokens, 4); err != nil {
+			return "", err
+		}
+		fields := make([]string, 0, 4)
+		for i, name := range []string{"priority", "weight", "port"} {
+			v, err := parseUint16(name, tokens[i].value)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, strconv.Itoa(v))
+		}
+		target, err := p.absoluteName(tokens[3].value)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(append(fields, target), " "), nil
+
+	case TypeTXT:
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("expected at least one string")
+		}
+		value := ""
+		for _, t := range tokens {
+			value += t.value
+		}
+		return value, nil
+
+	case TypeSOA:
+		if err := expectFields(tokens, 7); err != nil {
+			return "", err
+		}
+		host, err := p.absoluteName(tokens[0].value)
+		if err != nil {
+			return "", err
+		}
+		email, err := p.absoluteName(tokens[1].value)
+		if err != nil {
+			return "", err
+		}
+		fields := []string{host, email}
+		serial, err := strconv.ParseUint(tokens[2].value, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a valid serial number", tokens[2].value)
+		}
+		fields = append(fields, strconv.FormatUint(serial, 10))
+		for _, t := range tokens[3:] {
+			v, err := parseTTL(t.value)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, strconv.FormatInt(v, 10))
+		}
+		return strings.Join(fields, " "), nil
+	}
+
+	return "", fmt.Errorf("the record type %q is not supported, supported types are %s", recordType, strings.Join(SupportedTypes(), ", "))
+}
+
+// absoluteName returns the fully qualified form of a domain name without the trailing period, resolving names
+// relative to the current origin
+func (p *parser) absoluteName(input string) (string, error) {
+	if input == "" {
+		return "", fmt.Errorf("expected a domain name")
+	}
+	if input == ApexName {
+		return p.origin, nil
+	}
+	if strings.HasSuffix(input, ".") {
+		name := strings.TrimSuffix(input, ".")
+		if name == "" || strings.HasSuffix(name, ".") {
+			return "", fmt.Errorf("%q is not a valid domain name", input)
+		}
+		return strings.ToLower(name), nil
+	}
+
+	return strings.ToLower(input + "." + p.origin), nil
+}
+
+// relativeName returns the name of a record relative to the zone
+func (p *parser) relativeName(input string) (string, error) {
+	name, err := p.absoluteName(input)
+	if err != nil {
+		return "", err
+	}
+
+	if name == p.zone {
+		return ApexName, nil
+	}
+	if !strings.HasSuffix(name, "."+p.zone) {
+		return "", fmt.Errorf("the name %q is outside of the zone %q", input, p.zone)
+	}
+
+	return strings.TrimSuffix(name, "."+p.zone), nil
+}
+
+func expectFields(tokens []token, count int) error {
+	if len(tokens) != count {
+		return fmt.Errorf("expected %d fields but got %d", count, len(tokens))
+	}
+	return nil
+}
+
+func parseUint16(name, input string) (int, error) {
+	v, err := strconv.ParseUint(input, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid %s", input, name)
+	}
+	return int(v), nil
+}
+
+// parseTTL parses a TTL in either seconds or the BIND shorthand format, e.g. `1h30m`
+func parseTTL(input string) (int64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("expected a TTL")
+	}
+
+	if v, err := strconv.ParseUint(input, 10, 32); err == nil {
+		return int64(v), nil
+	}
+
+	units := map[byte]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 60 * 60 * 24,
+		'w': 60 * 60 * 24 * 7,
+	}
+
+	total := int64(0)
+	digits := ""
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c >= '0' && c <= '9' {
+			digits += string(c)
+			continue
+		}
+		multiplier, ok := units[c|0x20]
+		if !ok || digits == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		v, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		digits = ""
+	}
+	if digits != "" {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+	if total > 1<<32-1 {
+		return 0, fmt.Errorf("%q exceeds the maximum TTL", input)
+	}
+
+	return total, nil
+}
+
+// tokenize splits a zone file into entries, removing comments and joining lines which are wrapped in parentheses
+func tokenize(input string) ([]entry, error) {
+	entries := make([]entry, 0)
+
+	line := 1
+	depth := 0
+	current := entry{line: line}
+	atLineStart := true
+
+	flush := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = entry{line: line}
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\n':
+			line++
+			if depth == 0 {
+				flush()
+			}
+			atLineStart = true
+
+		case c == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+
+		case c == ' ' || c == '\t' || c == '\r':
+			if atLineStart && depth == 0 && len(current.tokens) == 0 {
+				current.inheritsOwner = true
+			}
+			atLineStart = false
+
+		case c == '(':
+			depth++
+			atLineStart = false
+
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected `)`", line)
+			}
+			depth--
+			atLineStart = false
+
+		case c == '"':
+			value := make([]rune, 0)
+			closed := false
+			for i+1 < len(runes) {
+				i++
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					value = append(value, runes[i])
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					break
+				}
+				if runes[i] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+				}
+				value = append(value, runes[i])
+			}
+			if !closed {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+			current.tokens = append(current.tokens, token{value: string(value), quoted: true})
+			atLineStart = false
+
+		default:
+			value := []rune{c}
+			for i+1 < len(runes) && !strings.ContainsRune(" \t\r\n;()\"", runes[i+1]) {
+				i++
+				value = append(value, runes[i])
+			}
+			current.tokens = append(current.tokens, token{value: string(value)})
+			atLineStart = false
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.line)
+	}
+	flush()
+
+	return entries, nil
+}
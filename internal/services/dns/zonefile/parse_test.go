@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []RecordSet
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: []RecordSet{},
+		},
+		{
+			Name: "comments and blank lines",
+			Input: `
+; a comment
+
+www IN A 10.0.0.1 ; trailing comment
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: TypeA, TTL: DefaultTTL, Values: []string{"10.0.0.1"}},
+			},
+		},
+		{
+			Name: "ttl directive and explicit ttls",
+			Input: `$TTL 1h
+www A 10.0.0.1
+www 300 IN A 10.0.0.2
+api IN 2m AAAA 2001:db8::1
+`,
+			Expected: []RecordSet{
+				{Name: "www", Type: TypeA, TTL: 3600, Values: []string{"10.0.0.1", "10.0.0.2"}},
+				{Name: "api", Type: TypeAAAA, TTL: 120, Values: []string{"2001:db8::1"}},
+			},
+		},
+		{
+			Name: "owner names",
+			Input: `@ 60 IN MX 10 mail
+mail.example.com. 60 IN A 10.0.0.1
+ 60 IN A 10.0.0.2
+$ORIGIN sub.example.com.
+www 60 IN CNAME @
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: TypeMX, TTL: 60, Values: []string{"10 mail.example.com"}},
+				{Name: "mail", Type: TypeA, TTL: 60, Values: []string{"10.0.0.1", "10.0.0.2"}},
+				{Name: "www.sub", Type: TypeCNAME, TTL: 60, Values: []string{"sub.example.com"}},
+			},
+		},
+		{
+			Name: "multi-line soa",
+			Input: `@ IN SOA ns1.example.com. hostmaster (
+    2023010101 ; serial
+    1h         ; refresh
+    15m        ; retry
+    4w         ; expire
+    300 )      ; minimum
+@ IN NS ns1.example.com.
+`,
+			Expected: []RecordSet{
+				{Name: "@", Type: TypeSOA, TTL: DefaultTTL, Values: []string{"ns1.example.com hostmaster.example.com 2023010101 3600 900 2419200 300"}},
+				{Name: "@", Type: TypeNS, TTL: DefaultTTL, Values: []string{"ns1.example.com"}},
+			},
+		},
+		{
+			Name: "srv, ptr and txt",
+			Input: `_sip._tcp 60 IN SRV 1 5 5060 sip.example.net.
+1 60 IN PTR host
+@ 60 IN TXT "v=spf1 " "-all"
+@ 60 IN TXT "quote \" and ; semicolon"
+`,
+			Expected: []RecordSet{
+				{Name: "_sip._tcp", Type: TypeSRV, TTL: 60, Values: []string{"1 5 5060 sip.example.net"}},
+				{Name: "1", Type: TypePTR, TTL: 60, Values: []string{"host.example.com"}},
+				{Name: "@", Type: TypeTXT, TTL: 60, Values: []string{"v=spf1 -all", `quote " and ; semicolon`}},
+			},
+		},
+		{
+			Name:  "unsupported type",
+			Input: "@ IN CAA 0 issue \"letsencrypt.org\"",
+			Error: true,
+		},
+		{
+			Name:  "unsupported class",
+			Input: "www CH A 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name:  "outside of zone",
+			Input: "www.example.net. IN A 10.0.0.1",
+			Error: true,
+		},
+		{
+			Name:  "invalid ipv4 address",
+			Input: "www IN A 2001:db8::1",
+			Error: true,
+		},
+		{
+			Name:  "multiple cnames",
+			Input: "www IN CNAME a.example.net.\nwww IN CNAME b.example.net.",
+			Error: true,
+		},
+		{
+			Name:  "unbalanced parentheses",
+			Input: "@ IN SOA ns1 hostmaster ( 1 2 3 4 5",
+			Error: true,
+		},
+		{
+			Name:  "unsupported directive",
+			Input: "$INCLUDE other.zone",
+			Error: true,
+		},
+		{
+			Name:  "missing owner",
+			Input: " IN A 10.0.0.1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse(v.Input, "example.com", DefaultTTL)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v for %q but got %+v", v.Expected, v.Name, actual)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected int64
+		Error    bool
+	}{
+		{Input: "0", Expected: 0},
+		{Input: "300", Expected: 300},
+		{Input: "1h", Expected: 3600},
+		{Input: "1H30M", Expected: 5400},
+		{Input: "1w2d", Expected: 777600},
+		{Input: "", Error: true},
+		{Input: "h", Error: true},
+		{Input: "1x", Error: true},
+		{Input: "1h30", Error: true},
+		{Input: "A", Error: true},
+	}
+
+	for _, v := range testData {
+		actual, err := parseTTL(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("Expected no error for %q but got: %+v", v.Input, err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Input)
+		}
+		if actual != v.Expected {
+			t.Fatalf("Expected %d for %q but got %d", v.Expected, v.Input, actual)
+		}
+	}
+}
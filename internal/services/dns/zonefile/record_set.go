@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultTTL is the TTL used for records which don't specify one when the zone file has no `$TTL` directive,
+// which matches the default TTL of the SOA Record within the `azurestack_dns_zone` resource
+const DefaultTTL int64 = 3600
+
+// ApexName is the relative name used for records at the apex of the zone
+const ApexName = "@"
+
+const (
+	TypeA     = "A"
+	TypeAAAA  = "AAAA"
+	TypeCNAME = "CNAME"
+	TypeMX    = "MX"
+	TypeNS    = "NS"
+	TypePTR   = "PTR"
+	TypeSOA   = "SOA"
+	TypeSRV   = "SRV"
+	TypeTXT   = "TXT"
+)
+
+// SupportedTypes returns the record types which can be parsed and serialized
+func SupportedTypes() []string {
+	return []string{
+		TypeA,
+		TypeAAAA,
+		TypeCNAME,
+		TypeMX,
+		TypeNS,
+		TypePTR,
+		TypeSOA,
+		TypeSRV,
+		TypeTXT,
+	}
+}
+
+// RecordSet is a group of records sharing the same name and type.
+//
+// Name is relative to the zone (`@` for the apex) and Values are in presentation format, with any domain names
+// being fully qualified without a trailing period and TXT values being the unquoted concatenation of their strings.
+type RecordSet struct {
+	Name   string
+	Type   string
+	TTL    int64
+	Values []string
+}
+
+// Key returns an identifier which is unique for each Record Set within a zone
+func (rs RecordSet) Key() string {
+	return rs.Name + "/" + rs.Type
+}
+
+// IsAzureManaged returns whether this Record Set is created and managed by Azure for each zone, namely the SOA
+// Record and the NS Records at the apex of the zone
+func (rs RecordSet) IsAzureManaged() bool {
+	return rs.Name == ApexName && (rs.Type == TypeSOA || rs.Type == TypeNS)
+}
+
+// Equivalent returns whether two collections of Record Sets contain the same records, regardless of ordering
+func Equivalent(first, second []RecordSet) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	normalised := func(input []RecordSet) map[string]string {
+		output := make(map[string]string, len(input))
+		for _, rs := range input {
+			values := make([]string, len(rs.Values))
+			copy(values, rs.Values)
+			for i := range values {
+				if rs.Type != TypeTXT {
+					values[i] = strings.ToLower(values[i])
+				}
+			}
+			sort.Strings(values)
+			output[rs.Key()] = strings.Join(append([]string{strconv.FormatInt(rs.TTL, 10)}, values...), "\n")
+		}
+		return output
+	}
+
+	firstNormalised := normalised(first)
+	secondNormalised := normalised(second)
+	if len(firstNormalised) != len(secondNormalised) {
+		return false
+	}
+	for k, v := range firstNormalised {
+		if other, ok := secondNormalised[k]; !ok || other != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sort orders Record Sets as they're rendered within a zone file: the SOA Record followed by the Name Servers for the
+// zone, then by name (with the apex first) and type
+func Sort(input []RecordSet) {
+	typeOrder := make(map[string]int)
+	for i, v := range SupportedTypes() {
+		typeOrder[v] = i
+	}
+
+	rank := func(rs RecordSet) int {
+		switch {
+		case rs.Name == ApexName && rs.Type == TypeSOA:
+			return 0
+		case rs.Name == ApexName && rs.Type == TypeNS:
+			return 1
+		case rs.Name == ApexName:
+			return 2
+		}
+		return 3
+	}
+
+	sort.SliceStable(input, func(i, j int) bool {
+		if ri, rj := rank(input[i]), rank(input[j]); ri != rj {
+			return ri < rj
+		}
+		if input[i].Name != input[j].Name {
+			return input[i].Name < input[j].Name
+		}
+		return typeOrder[input[i].Type] < typeOrder[input[j].Type]
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// txtSegmentLength is the maximum length of a single character-string within a TXT record
+const txtSegmentLength = 255
+
+// Serialize renders Record Sets within the zone `zoneName` as a BIND zone file.
+//
+// Names are rendered relative to `$ORIGIN` and every record is given an explicit TTL, so that the output doesn't
+// depend on a `$TTL` directive.
+func Serialize(zoneName string, recordSets []RecordSet) string {
+	zone := strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	sorted := make([]RecordSet, len(recordSets))
+	copy(sorted, recordSets)
+	Sort(sorted)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "$ORIGIN %s.\n", zone)
+
+	w := tabwriter.NewWriter(&buf, 0, 8, 1, ' ', 0)
+	for _, rs := range sorted {
+		for _, value := range rs.Values {
+			fmt.Fprintf(w, "%s\t%d\tIN\t%s\t%s\n", rs.Name, rs.TTL, rs.Type, formatValue(rs.Type, value))
+		}
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+func formatValue(recordType, value string) string {
+	switch recordType {
+	case TypeCNAME, TypeNS, TypePTR:
+		return fullyQualified(value)
+
+	case TypeMX:
+		// preference exchange
+		if fields := strings.Fields(value); len(fields) == 2 {
+			return fmt.Sprintf("%s %s", fields[0], fullyQualified(fields[1]))
+		}
+
+	case TypeSRV:
+		// priority weight port target
+		if fields := strings.Fields(value); len(fields) == 4 {
+			return fmt.Sprintf("%s %s %s %s", fields[0], fields[1], fields[2], fullyQualified(fields[3]))
+		}
+
+	case TypeSOA:
+		// host email serial refresh retry expire minimum
+		if fields := strings.Fields(value); len(fields) == 7 {
+			return fmt.Sprintf("%s %s %s", fullyQualified(fields[0]), fullyQualified(fields[1]), strings.Join(fields[2:], " "))
+		}
+
+	case TypeTXT:
+		segments := make([]string, 0)
+		for len(value) > txtSegmentLength {
+			segments = append(segments, quote(value[:txtSegmentLength]))
+			value = value[txtSegmentLength:]
+		}
+		segments = append(segments, quote(value))
+		return strings.Join(segments, " ")
+	}
+
+	return value
+}
+
+func fullyQualified(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func quote(input string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(input) + `"`
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSerialize(t *testing.T) {
+	recordSets := []RecordSet{
+		{Name: "www", Type: TypeCNAME, TTL: 300, Values: []string{"example.net"}},
+		{Name: "@", Type: TypeNS, TTL: 172800, Values: []string{"ns1.example.com."}},
+		{Name: "@", Type: TypeTXT, TTL: 60, Values: []string{`say "hi"`}},
+		{Name: "@", Type: TypeSOA, TTL: 3600, Values: []string{"ns1.example.com hostmaster.example.com 1 3600 300 2419200 300"}},
+		{Name: "_sip._tcp", Type: TypeSRV, TTL: 60, Values: []string{"1 5 5060 sip.example.net"}},
+		{Name: "@", Type: TypeMX, TTL: 60, Values: []string{"10 mail.example.com"}},
+		{Name: "mail", Type: TypeA, TTL: 60, Values: []string{"10.0.0.1", "10.0.0.2"}},
+	}
+
+	expected := `$ORIGIN example.com.
+@         3600   IN SOA   ns1.example.com. hostmaster.example.com. 1 3600 300 2419200 300
+@         172800 IN NS    ns1.example.com.
+@         60     IN MX    10 mail.example.com.
+@         60     IN TXT   "say \"hi\""
+_sip._tcp 60     IN SRV   1 5 5060 sip.example.net.
+mail      60     IN A     10.0.0.1
+mail      60     IN A     10.0.0.2
+www       300    IN CNAME example.net.
+`
+
+	actual := Serialize("example.com.", recordSets)
+	if actual != expected {
+		t.Fatalf("Expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestSerializeLongTxtRecord(t *testing.T) {
+	value := strings.Repeat("a", 300)
+	actual := formatValue(TypeTXT, value)
+	expected := `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	input := `$TTL 300
+@ IN SOA ns1.example.com. hostmaster.example.com. ( 1 3600 300 2419200 300 )
+@ IN NS ns1.example.com.
+@ IN NS ns2.example.com.
+@ IN MX 10 mail
+@ IN TXT "v=spf1 include:example.net -all"
+mail IN A 10.0.0.1
+ipv6 IN AAAA 2001:db8::1
+www IN CNAME mail
+_ldap._tcp IN SRV 0 100 389 ldap
+10 IN PTR host.example.com.
+`
+
+	parsed, err := Parse(input, "example.com", DefaultTTL)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	reparsed, err := Parse(Serialize("example.com", parsed), "example.com", DefaultTTL)
+	if err != nil {
+		t.Fatalf("parsing serialized zone file: %+v", err)
+	}
+
+	if !Equivalent(parsed, reparsed) {
+		t.Fatalf("Expected %+v but got %+v", parsed, reparsed)
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	first := []RecordSet{
+		{Name: "www", Type: TypeA, TTL: 60, Values: []string{"10.0.0.1", "10.0.0.2"}},
+		{Name: "@", Type: TypeCNAME, TTL: 60, Values: []string{"Example.net"}},
+	}
+
+	if !Equivalent(first, []RecordSet{
+		{Name: "@", Type: TypeCNAME, TTL: 60, Values: []string{"example.net"}},
+		{Name: "www", Type: TypeA, TTL: 60, Values: []string{"10.0.0.2", "10.0.0.1"}},
+	}) {
+		t.Fatalf("Expected the Record Sets to be equivalent")
+	}
+
+	if Equivalent(first, []RecordSet{
+		{Name: "@", Type: TypeCNAME, TTL: 60, Values: []string{"example.net"}},
+		{Name: "www", Type: TypeA, TTL: 300, Values: []string{"10.0.0.2", "10.0.0.1"}},
+	}) {
+		t.Fatalf("Expected Record Sets with differing TTLs not to be equivalent")
+	}
+}
@@ -18,6 +18,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 )
 
+type recoverNestedItem interface {
+	RecoverNestedItem(ctx context.Context) (autorest.Response, error)
+	NestedItemHasBeenRecovered(ctx context.Context) (autorest.Response, error)
+}
+
 type deleteAndPurgeNestedItem interface {
 	DeleteNestedItem(ctx context.Context) (autorest.Response, error)
 	NestedItemHasBeenDeleted(ctx context.Context) (autorest.Response, error)
@@ -103,6 +108,77 @@ func deleteAndOptionallyPurge(ctx context.Context, description string, shouldPur
 	return nil
 }
 
+// shouldPurgeNestedItems returns whether Nested Items (such as Certificates, Keys and Secrets) within the specified
+// Key Vault should be purged once they've been deleted. Purging is only possible when Soft Delete is enabled and
+// Purge Protection is disabled for the Key Vault.
+func shouldPurgeNestedItems(ctx context.Context, meta interface{}, keyVaultId parse.VaultId) (bool, error) {
+	if !meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		return false, nil
+	}
+
+	resp, err := meta.(*clients.Client).KeyVault.VaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	if err != nil {
+		return false, fmt.Errorf("retrieving %s: %+v", keyVaultId, err)
+	}
+
+	props := resp.Properties
+	if props == nil || props.EnableSoftDelete == nil || !*props.EnableSoftDelete {
+		return false, nil
+	}
+
+	if props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] Unable to purge Nested Items within %s since Purge Protection is enabled", keyVaultId)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// softDeletedNestedItemExistsError returns the error raised when a soft-deleted Nested Item with the same name exists
+// but the user has opted out of recovering it
+func softDeletedNestedItemExistsError(description string, featureFlag string) error {
+	return fmt.Errorf("an existing soft-deleted %s was found. This needs to be either recovered by setting `%s` to `true` within the `key_vault` block in the Provider `features` block, or purged, before it can be created", description, featureFlag)
+}
+
+func recoverSoftDeletedNestedItem(ctx context.Context, description string, helper recoverNestedItem) error {
+	timeout, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+
+	log.Printf("[DEBUG] Recovering soft-deleted %s..", description)
+	if _, err := helper.RecoverNestedItem(ctx); err != nil {
+		return fmt.Errorf("recovering soft-deleted %s: %+v", description, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to finish recovering..", description)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"NotFound"},
+		Target:  []string{"Available"},
+		Refresh: func() (interface{}, string, error) {
+			item, err := helper.NestedItemHasBeenRecovered(ctx)
+			if err != nil {
+				if utils.ResponseWasNotFound(item) {
+					return item, "NotFound", nil
+				}
+
+				return nil, "Error", err
+			}
+
+			return item, "Available", nil
+		},
+		ContinuousTargetOccurence: 3,
+		PollInterval:              5 * time.Second,
+		Timeout:                   time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be recovered: %+v", description, err)
+	}
+	log.Printf("[DEBUG] Recovered %s.", description)
+
+	return nil
+}
+
 func nestedItemResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	resourcesClient := meta.(*clients.Client).Resource
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func keyVaultCertificateDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyVaultCertificateDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"versionless_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versionless_secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_policy": keyVaultCertificatePolicySchemaDataSource(),
+
+			"certificate_attribute": keyVaultCertificateAttributeSchema(),
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func keyVaultCertificatePolicySchemaDataSource() *schema.Schema {
+	computedStrings := func(t schema.ValueType) *schema.Schema {
+		return &schema.Schema{
+			Type:     t,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+	computedBlock := func(s map[string]*schema.Schema) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: s,
+			},
+		}
+	}
+	computed := func(t schema.ValueType) *schema.Schema {
+		return &schema.Schema{
+			Type:     t,
+			Computed: true,
+		}
+	}
+
+	return computedBlock(map[string]*schema.Schema{
+		"issuer_parameters": computedBlock(map[string]*schema.Schema{
+			"name": computed(schema.TypeString),
+		}),
+
+		"key_properties": computedBlock(map[string]*schema.Schema{
+			"exportable": computed(schema.TypeBool),
+			"key_size":   computed(schema.TypeInt),
+			"key_type":   computed(schema.TypeString),
+			"reuse_key":  computed(schema.TypeBool),
+		}),
+
+		"lifetime_action": computedBlock(map[string]*schema.Schema{
+			"action": computedBlock(map[string]*schema.Schema{
+				"action_type": computed(schema.TypeString),
+			}),
+			"trigger": computedBlock(map[string]*schema.Schema{
+				"days_before_expiry":  computed(schema.TypeInt),
+				"lifetime_percentage": computed(schema.TypeInt),
+			}),
+		}),
+
+		"secret_properties": computedBlock(map[string]*schema.Schema{
+			"content_type": computed(schema.TypeString),
+		}),
+
+		"x509_certificate_properties": computedBlock(map[string]*schema.Schema{
+			"extended_key_usage": computedStrings(schema.TypeList),
+			"key_usage":          computedStrings(schema.TypeSet),
+			"subject":            computed(schema.TypeString),
+			"subject_alternative_names": computedBlock(map[string]*schema.Schema{
+				"dns_names": computedStrings(schema.TypeSet),
+				"emails":    computedStrings(schema.TypeSet),
+				"upns":      computedStrings(schema.TypeSet),
+			}),
+			"validity_in_months": computed(schema.TypeInt),
+		}),
+	})
+}
+
+func keyVaultCertificateDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Certificate %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	// an empty version retrieves the latest version
+	resp, err := client.GetCertificate(ctx, *keyVaultBaseUri, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("KeyVault Certificate %q (KeyVault URI %q) does not exist", name, *keyVaultBaseUri)
+		}
+		return fmt.Errorf("making Read request on Azure KeyVault Certificate %s: %+v", name, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("retrieving Certificate %q (KeyVault URI %q): `id` was nil", name, *keyVaultBaseUri)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("key_vault_id", keyVaultId.ID())
+
+	return setKeyVaultCertificateBundle(d, resp)
+}
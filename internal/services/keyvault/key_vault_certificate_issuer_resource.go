@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func keyVaultCertificateIssuer() *schema.Resource {
+	return &schema.Resource{
+		Create: keyVaultCertificateIssuerCreateOrUpdate,
+		Read:   keyVaultCertificateIssuerRead,
+		Update: keyVaultCertificateIssuerCreateOrUpdate,
+		Delete: keyVaultCertificateIssuerDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IssuerID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DigiCert",
+					"GlobalSign",
+					"OneCertV2-PrivateCA",
+					"OneCertV2-PublicCA",
+					"SslAdminV2",
+				}, false),
+			},
+
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"admin": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"first_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"last_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"phone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func keyVaultCertificateIssuerCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Certificate Issuer %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	id, err := parse.NewIssuerID(*keyVaultBaseUrl, name)
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.GetCertificateIssuer(ctx, *keyVaultBaseUrl, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Certificate Issuer %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurestack_key_vault_certificate_issuer", id.ID())
+		}
+	}
+
+	parameters := keyvault.CertificateIssuerSetParameters{
+		Provider: utils.String(d.Get("provider_name").(string)),
+		OrganizationDetails: &keyvault.OrganizationDetails{
+			AdminDetails: expandKeyVaultCertificateIssuerAdmins(d.Get("admin").([]interface{})),
+		},
+	}
+
+	if orgId := d.Get("org_id").(string); orgId != "" {
+		parameters.OrganizationDetails.ID = utils.String(orgId)
+	}
+
+	accountId := d.Get("account_id").(string)
+	password := d.Get("password").(string)
+	if accountId != "" || password != "" {
+		parameters.Credentials = &keyvault.IssuerCredentials{}
+		if accountId != "" {
+			parameters.Credentials.AccountID = utils.String(accountId)
+		}
+		if password != "" {
+			parameters.Credentials.Password = utils.String(password)
+		}
+	}
+
+	if _, err := client.SetCertificateIssuer(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("setting Certificate Issuer %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return keyVaultCertificateIssuerRead(d, meta)
+}
+
+func keyVaultCertificateIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Certificate Issuer %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Certificate Issuer %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Issuer %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("making Read request on Azure KeyVault Certificate Issuer %s: %+v", id.Name, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("provider_name", resp.Provider)
+
+	orgId := ""
+	admins := make([]interface{}, 0)
+	if org := resp.OrganizationDetails; org != nil {
+		if org.ID != nil {
+			orgId = *org.ID
+		}
+		admins = flattenKeyVaultCertificateIssuerAdmins(org.AdminDetails)
+	}
+	d.Set("org_id", orgId)
+	if err := d.Set("admin", admins); err != nil {
+		return fmt.Errorf("setting `admin`: %+v", err)
+	}
+
+	// the password isn't returned by the API, so we retain the value from the config
+	accountId := ""
+	if creds := resp.Credentials; creds != nil && creds.AccountID != nil {
+		accountId = *creds.AccountID
+	}
+	d.Set("account_id", accountId)
+
+	return nil
+}
+
+func keyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("deleting Certificate Issuer %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultCertificateIssuerAdmins(input []interface{}) *[]keyvault.AdministratorDetails {
+	results := make([]keyvault.AdministratorDetails, 0)
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		raw := v.(map[string]interface{})
+
+		admin := keyvault.AdministratorDetails{
+			EmailAddress: utils.String(raw["email_address"].(string)),
+		}
+		if firstName := raw["first_name"].(string); firstName != "" {
+			admin.FirstName = utils.String(firstName)
+		}
+		if lastName := raw["last_name"].(string); lastName != "" {
+			admin.LastName = utils.String(lastName)
+		}
+		if phone := raw["phone"].(string); phone != "" {
+			admin.Phone = utils.String(phone)
+		}
+
+		results = append(results, admin)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateIssuerAdmins(input *[]keyvault.AdministratorDetails) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, admin := range *input {
+		emailAddress := ""
+		if admin.EmailAddress != nil {
+			emailAddress = *admin.EmailAddress
+		}
+		firstName := ""
+		if admin.FirstName != nil {
+			firstName = *admin.FirstName
+		}
+		lastName := ""
+		if admin.LastName != nil {
+			lastName = *admin.LastName
+		}
+		phone := ""
+		if admin.Phone != nil {
+			phone = *admin.Phone
+		}
+
+		results = append(results, map[string]interface{}{
+			"email_address": emailAddress,
+			"first_name":    firstName,
+			"last_name":     lastName,
+			"phone":         phone,
+		})
+	}
+
+	return results
+}
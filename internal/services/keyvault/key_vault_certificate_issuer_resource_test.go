@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type KeyVaultCertificateIssuerResource struct{}
+
+func TestAccKeyVaultCertificateIssuer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_certificate_issuer", "test")
+	r := KeyVaultCertificateIssuerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccKeyVaultCertificateIssuer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_certificate_issuer", "test")
+	r := KeyVaultCertificateIssuerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_key_vault_certificate_issuer"),
+		},
+	})
+}
+
+func TestAccKeyVaultCertificateIssuer_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_certificate_issuer", "test")
+	r := KeyVaultCertificateIssuerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccKeyVaultCertificateIssuer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_certificate_issuer", "test")
+	r := KeyVaultCertificateIssuerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (KeyVaultCertificateIssuerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.IssuerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key Vault Certificate Issuer %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r KeyVaultCertificateIssuerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_certificate_issuer" "test" {
+  name          = "acctestKVCI-%d"
+  key_vault_id  = azurestack_key_vault.test.id
+  account_id    = "test-account"
+  password      = "test"
+  provider_name = "DigiCert"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KeyVaultCertificateIssuerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_certificate_issuer" "import" {
+  name          = azurestack_key_vault_certificate_issuer.test.name
+  key_vault_id  = azurestack_key_vault_certificate_issuer.test.key_vault_id
+  account_id    = azurestack_key_vault_certificate_issuer.test.account_id
+  password      = "test"
+  provider_name = azurestack_key_vault_certificate_issuer.test.provider_name
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultCertificateIssuerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_certificate_issuer" "test" {
+  name          = "acctestKVCI-%d"
+  key_vault_id  = azurestack_key_vault.test.id
+  account_id    = "test-account"
+  password      = "test"
+  provider_name = "DigiCert"
+  org_id        = "accTestOrg"
+
+  admin {
+    email_address = "admin@contoso.com"
+    first_name    = "First"
+    last_name     = "Last"
+    phone         = "01234567890"
+  }
+
+  admin {
+    email_address = "admin2@contoso.com"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (KeyVaultCertificateIssuerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  tenant_id           = data.azurestack_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurestack_client_config.current.tenant_id
+    object_id = data.azurestack_client_config.current.service_principal_object_id
+
+    certificate_permissions = [
+      "DeleteIssuers",
+      "GetIssuers",
+      "ListIssuers",
+      "ManageContacts",
+      "ManageIssuers",
+      "SetIssuers",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,1059 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+const (
+	keyVaultCertificateContentTypePem    = "application/x-pem-file"
+	keyVaultCertificateContentTypePkcs12 = "application/x-pkcs12"
+)
+
+func keyVaultCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: keyVaultCertificateCreate,
+		Read:   keyVaultCertificateRead,
+		Update: keyVaultCertificateUpdate,
+		Delete: keyVaultCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: nestedItemResourceImporter,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"certificate": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"certificate", "certificate_policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contents": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"certificate_policy": keyVaultCertificatePolicySchema(),
+
+			"tags": tags.Schema(),
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versionless_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versionless_secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_attribute": keyVaultCertificateAttributeSchema(),
+		},
+	}
+}
+
+func keyVaultCertificatePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"issuer_parameters": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"key_properties": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"exportable": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+
+							"key_size": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ValidateFunc: validation.IntInSlice([]int{
+									2048,
+									3072,
+									4096,
+								}),
+							},
+
+							"key_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(keyvault.RSA),
+									string(keyvault.RSAHSM),
+								}, false),
+							},
+
+							"reuse_key": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"lifetime_action": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"action": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"action_type": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(keyvault.AutoRenew),
+												string(keyvault.EmailContacts),
+											}, false),
+										},
+									},
+								},
+							},
+
+							"trigger": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"days_before_expiry": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+
+										"lifetime_percentage": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 99),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"secret_properties": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"content_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									keyVaultCertificateContentTypePem,
+									keyVaultCertificateContentTypePkcs12,
+								}, false),
+							},
+						},
+					},
+				},
+
+				"x509_certificate_properties": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"extended_key_usage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"key_usage": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(keyvault.CRLSign),
+										string(keyvault.DataEncipherment),
+										string(keyvault.DecipherOnly),
+										string(keyvault.DigitalSignature),
+										string(keyvault.EncipherOnly),
+										string(keyvault.KeyAgreement),
+										string(keyvault.KeyCertSign),
+										string(keyvault.KeyEncipherment),
+										string(keyvault.NonRepudiation),
+									}, false),
+								},
+							},
+
+							"subject": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"subject_alternative_names": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dns_names": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+
+										"emails": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+
+										"upns": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+									},
+								},
+							},
+
+							"validity_in_months": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func keyVaultCertificateAttributeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"created": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+
+				"expires": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"not_before": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"recovery_level": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"updated": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func keyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Certificate %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	existing, err := client.GetCertificate(ctx, *keyVaultBaseUrl, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Certificate %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_key_vault_certificate", *existing.ID)
+	}
+
+	policy := expandKeyVaultCertificatePolicy(d.Get("certificate_policy").([]interface{}))
+	t := d.Get("tags").(map[string]interface{})
+
+	if v, ok := d.GetOk("certificate"); ok {
+		if err := keyVaultCertificateImport(ctx, client, *keyVaultBaseUrl, name, v.([]interface{}), policy, t); err != nil {
+			return err
+		}
+	} else {
+		if policy == nil {
+			return fmt.Errorf("a `certificate_policy` block must be specified when generating Certificate %q (Key Vault %q)", name, *keyVaultBaseUrl)
+		}
+
+		parameters := keyvault.CertificateCreateParameters{
+			CertificatePolicy: policy,
+			Tags:              tags.Expand(t),
+		}
+		if _, err := client.CreateCertificate(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+			return fmt.Errorf("creating Certificate %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+
+		// Certificates using the `Unknown` issuer remain pending until the signed certificate is merged, which
+		// isn't supported by this resource and so has to be done outside of Terraform
+		if issuer := policy.IssuerParameters; issuer != nil && issuer.Name != nil && strings.EqualFold(*issuer.Name, "Unknown") {
+			log.Printf("[DEBUG] Certificate %q (Key Vault %q) uses the `Unknown` issuer - not waiting for issuance", name, *keyVaultBaseUrl)
+		} else {
+			log.Printf("[DEBUG] Waiting for Certificate %q (Key Vault %q) to be issued..", name, *keyVaultBaseUrl)
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"inProgress"},
+				Target:     []string{"completed"},
+				Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, *keyVaultBaseUrl, name),
+				MinTimeout: 15 * time.Second,
+				Timeout:    d.Timeout(schema.TimeoutCreate),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for Certificate %q (Key Vault %q) to be issued: %+v", name, *keyVaultBaseUrl, err)
+			}
+		}
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetCertificate(ctx, *keyVaultBaseUrl, name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("retrieving Certificate %q (Key Vault %q): `id` was nil", name, *keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return keyVaultCertificateRead(d, meta)
+}
+
+func keyVaultCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policy := expandKeyVaultCertificatePolicy(d.Get("certificate_policy").([]interface{}))
+	t := d.Get("tags").(map[string]interface{})
+
+	if d.HasChange("certificate") {
+		// importing the new certificate contents creates a new version of the Certificate
+		if err := keyVaultCertificateImport(ctx, client, id.KeyVaultBaseUrl, id.Name, d.Get("certificate").([]interface{}), policy, t); err != nil {
+			return err
+		}
+	} else {
+		if d.HasChange("certificate_policy") && policy != nil {
+			if _, err := client.UpdateCertificatePolicy(ctx, id.KeyVaultBaseUrl, id.Name, *policy); err != nil {
+				return fmt.Errorf("updating the Policy for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+		}
+
+		if d.HasChange("tags") {
+			parameters := keyvault.CertificateUpdateParameters{
+				Tags: tags.Expand(t),
+			}
+			if _, err := client.UpdateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+				return fmt.Errorf("updating Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+		}
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	if _, err = parse.ParseNestedItemID(*read.ID); err != nil {
+		return err
+	}
+
+	// the ID is suffixed with the certificate version
+	d.SetId(*read.ID)
+
+	return keyVaultCertificateRead(d, meta)
+}
+
+func keyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Certificate %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Certificate %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	// we always want to get the latest version
+	resp, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("making Read request on Azure KeyVault Certificate %s: %+v", id.Name, err)
+	}
+
+	d.Set("key_vault_id", keyVaultId.ID())
+
+	return setKeyVaultCertificateBundle(d, resp)
+}
+
+func keyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", id.KeyVaultBaseUrl)
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Certificate %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Certificate %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	shouldPurge, err := shouldPurgeNestedItems(ctx, meta, *keyVaultId)
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Certificate %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
+	deleter := deleteAndPurgeCertificate{
+		client:      client,
+		keyVaultUri: id.KeyVaultBaseUrl,
+		name:        id.Name,
+	}
+	if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func keyVaultCertificateImport(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl, name string, input []interface{}, policy *keyvault.CertificatePolicy, t map[string]interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return fmt.Errorf("a `certificate` block must be specified when importing Certificate %q (Key Vault %q)", name, keyVaultBaseUrl)
+	}
+
+	raw := input[0].(map[string]interface{})
+	contents := raw["contents"].(string)
+
+	// Key Vault needs to be told the content type to be able to parse the contents - which is always derived from the
+	// contents, since when re-importing the policy (from the state) may have the content type of the previous contents
+	if policy == nil {
+		policy = &keyvault.CertificatePolicy{}
+	}
+	if policy.SecretProperties == nil {
+		policy.SecretProperties = &keyvault.SecretProperties{}
+	}
+	policy.SecretProperties.ContentType = utils.String(keyVaultCertificateContentType(contents))
+
+	parameters := keyvault.CertificateImportParameters{
+		Base64EncodedCertificate: utils.String(contents),
+		CertificatePolicy:        policy,
+		Tags:                     tags.Expand(t),
+	}
+	if password := raw["password"].(string); password != "" {
+		parameters.Password = utils.String(password)
+	}
+
+	if _, err := client.ImportCertificate(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("importing Certificate %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// keyVaultCertificateContentType returns the content type of the certificate contents, which are either PEM
+// files (sent as-is) or base64 encoded PKCS#12 (PFX) files
+func keyVaultCertificateContentType(contents string) string {
+	if strings.Contains(contents, "-----BEGIN") {
+		return keyVaultCertificateContentTypePem
+	}
+
+	return keyVaultCertificateContentTypePkcs12
+}
+
+func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Checking the status of the pending operation for Certificate %q (Key Vault %q)", name, keyVaultBaseUrl)
+		operation, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the pending operation for Certificate %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+
+		if operation.Status == nil {
+			return nil, "", fmt.Errorf("retrieving the pending operation for Certificate %q (Key Vault %q): `status` was nil", name, keyVaultBaseUrl)
+		}
+
+		status := *operation.Status
+		if !strings.EqualFold(status, "inProgress") && !strings.EqualFold(status, "completed") {
+			message := ""
+			if operation.Error != nil && operation.Error.Message != nil {
+				message = *operation.Error.Message
+			} else if operation.StatusDetails != nil {
+				message = *operation.StatusDetails
+			}
+			return nil, status, fmt.Errorf("issuing Certificate %q (Key Vault %q) finished with the status %q: %s", name, keyVaultBaseUrl, status, message)
+		}
+
+		return operation, strings.ToLower(status[:1]) + status[1:], nil
+	}
+}
+
+func setKeyVaultCertificateBundle(d *schema.ResourceData, input keyvault.CertificateBundle) error {
+	if input.ID == nil {
+		return fmt.Errorf("`id` was nil")
+	}
+
+	// the version may have changed, so parse the updated id
+	id, err := parse.ParseNestedItemID(*input.ID)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", id.Name)
+	d.Set("version", id.Version)
+	d.Set("versionless_id", strings.TrimSuffix(*input.ID, "/"+id.Version))
+
+	secretId := ""
+	versionlessSecretId := ""
+	if input.Sid != nil {
+		secretId = *input.Sid
+		if sid, err := parse.ParseNestedItemID(secretId); err == nil {
+			versionlessSecretId = strings.TrimSuffix(secretId, "/"+sid.Version)
+		}
+	}
+	d.Set("secret_id", secretId)
+	d.Set("versionless_secret_id", versionlessSecretId)
+
+	certificateData := ""
+	certificateDataBase64 := ""
+	if input.Cer != nil {
+		certificateData = strings.ToUpper(hex.EncodeToString(*input.Cer))
+		certificateDataBase64 = base64.StdEncoding.EncodeToString(*input.Cer)
+	}
+	d.Set("certificate_data", certificateData)
+	d.Set("certificate_data_base64", certificateDataBase64)
+
+	thumbprint := ""
+	if input.X509Thumbprint != nil {
+		// the thumbprint is returned as an unpadded base64url encoded SHA-1 hash
+		decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*input.X509Thumbprint, "="))
+		if err != nil {
+			return fmt.Errorf("decoding the thumbprint %q: %+v", *input.X509Thumbprint, err)
+		}
+		thumbprint = strings.ToUpper(hex.EncodeToString(decoded))
+	}
+	d.Set("thumbprint", thumbprint)
+
+	if err := d.Set("certificate_policy", flattenKeyVaultCertificatePolicy(input.Policy)); err != nil {
+		return fmt.Errorf("setting `certificate_policy`: %+v", err)
+	}
+
+	if err := d.Set("certificate_attribute", flattenKeyVaultCertificateAttributes(input.Attributes)); err != nil {
+		return fmt.Errorf("setting `certificate_attribute`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, input.Tags)
+}
+
+func expandKeyVaultCertificatePolicy(input []interface{}) *keyvault.CertificatePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	policy := keyvault.CertificatePolicy{}
+
+	if v := raw["issuer_parameters"].([]interface{}); len(v) > 0 && v[0] != nil {
+		issuer := v[0].(map[string]interface{})
+		policy.IssuerParameters = &keyvault.IssuerParameters{
+			Name: utils.String(issuer["name"].(string)),
+		}
+	}
+
+	if v := raw["key_properties"].([]interface{}); len(v) > 0 && v[0] != nil {
+		props := v[0].(map[string]interface{})
+		policy.KeyProperties = &keyvault.KeyProperties{
+			Exportable: utils.Bool(props["exportable"].(bool)),
+			KeySize:    utils.Int32(int32(props["key_size"].(int))),
+			KeyType:    utils.String(props["key_type"].(string)),
+			ReuseKey:   utils.Bool(props["reuse_key"].(bool)),
+		}
+	}
+
+	lifetimeActions := make([]keyvault.LifetimeAction, 0)
+	for _, v := range raw["lifetime_action"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		action := v.(map[string]interface{})
+		lifetimeAction := keyvault.LifetimeAction{}
+
+		if a := action["action"].([]interface{}); len(a) > 0 && a[0] != nil {
+			actionRaw := a[0].(map[string]interface{})
+			lifetimeAction.Action = &keyvault.Action{
+				ActionType: keyvault.ActionType(actionRaw["action_type"].(string)),
+			}
+		}
+
+		if t := action["trigger"].([]interface{}); len(t) > 0 && t[0] != nil {
+			triggerRaw := t[0].(map[string]interface{})
+			trigger := keyvault.Trigger{}
+			if days := triggerRaw["days_before_expiry"].(int); days > 0 {
+				trigger.DaysBeforeExpiry = utils.Int32(int32(days))
+			}
+			if percentage := triggerRaw["lifetime_percentage"].(int); percentage > 0 {
+				trigger.LifetimePercentage = utils.Int32(int32(percentage))
+			}
+			lifetimeAction.Trigger = &trigger
+		}
+
+		lifetimeActions = append(lifetimeActions, lifetimeAction)
+	}
+	policy.LifetimeActions = &lifetimeActions
+
+	if v := raw["secret_properties"].([]interface{}); len(v) > 0 && v[0] != nil {
+		props := v[0].(map[string]interface{})
+		policy.SecretProperties = &keyvault.SecretProperties{
+			ContentType: utils.String(props["content_type"].(string)),
+		}
+	}
+
+	if v := raw["x509_certificate_properties"].([]interface{}); len(v) > 0 && v[0] != nil {
+		props := v[0].(map[string]interface{})
+
+		extendedKeyUsage := make([]string, 0)
+		for _, usage := range props["extended_key_usage"].([]interface{}) {
+			extendedKeyUsage = append(extendedKeyUsage, usage.(string))
+		}
+
+		keyUsage := make([]keyvault.KeyUsageType, 0)
+		for _, usage := range props["key_usage"].(*schema.Set).List() {
+			keyUsage = append(keyUsage, keyvault.KeyUsageType(usage.(string)))
+		}
+
+		x509 := keyvault.X509CertificateProperties{
+			Ekus:             &extendedKeyUsage,
+			KeyUsage:         &keyUsage,
+			Subject:          utils.String(props["subject"].(string)),
+			ValidityInMonths: utils.Int32(int32(props["validity_in_months"].(int))),
+		}
+
+		if sans := props["subject_alternative_names"].([]interface{}); len(sans) > 0 && sans[0] != nil {
+			sansRaw := sans[0].(map[string]interface{})
+			x509.SubjectAlternativeNames = &keyvault.SubjectAlternativeNames{
+				DNSNames: utils.ExpandStringSlice(sansRaw["dns_names"].(*schema.Set).List()),
+				Emails:   utils.ExpandStringSlice(sansRaw["emails"].(*schema.Set).List()),
+				Upns:     utils.ExpandStringSlice(sansRaw["upns"].(*schema.Set).List()),
+			}
+		}
+
+		policy.X509CertificateProperties = &x509
+	}
+
+	return &policy
+}
+
+func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	issuerParameters := make([]interface{}, 0)
+	if issuer := input.IssuerParameters; issuer != nil {
+		name := ""
+		if issuer.Name != nil {
+			name = *issuer.Name
+		}
+		issuerParameters = append(issuerParameters, map[string]interface{}{
+			"name": name,
+		})
+	}
+
+	keyProperties := make([]interface{}, 0)
+	if props := input.KeyProperties; props != nil {
+		exportable := false
+		if props.Exportable != nil {
+			exportable = *props.Exportable
+		}
+		keySize := 0
+		if props.KeySize != nil {
+			keySize = int(*props.KeySize)
+		}
+		keyType := ""
+		if props.KeyType != nil {
+			keyType = *props.KeyType
+		}
+		reuseKey := false
+		if props.ReuseKey != nil {
+			reuseKey = *props.ReuseKey
+		}
+		keyProperties = append(keyProperties, map[string]interface{}{
+			"exportable": exportable,
+			"key_size":   keySize,
+			"key_type":   keyType,
+			"reuse_key":  reuseKey,
+		})
+	}
+
+	lifetimeActions := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			actions := make([]interface{}, 0)
+			if action.Action != nil {
+				actions = append(actions, map[string]interface{}{
+					"action_type": string(action.Action.ActionType),
+				})
+			}
+
+			triggers := make([]interface{}, 0)
+			if trigger := action.Trigger; trigger != nil {
+				daysBeforeExpiry := 0
+				if trigger.DaysBeforeExpiry != nil {
+					daysBeforeExpiry = int(*trigger.DaysBeforeExpiry)
+				}
+				lifetimePercentage := 0
+				if trigger.LifetimePercentage != nil {
+					lifetimePercentage = int(*trigger.LifetimePercentage)
+				}
+				triggers = append(triggers, map[string]interface{}{
+					"days_before_expiry":  daysBeforeExpiry,
+					"lifetime_percentage": lifetimePercentage,
+				})
+			}
+
+			lifetimeActions = append(lifetimeActions, map[string]interface{}{
+				"action":  actions,
+				"trigger": triggers,
+			})
+		}
+	}
+
+	secretProperties := make([]interface{}, 0)
+	if props := input.SecretProperties; props != nil {
+		contentType := ""
+		if props.ContentType != nil {
+			contentType = *props.ContentType
+		}
+		secretProperties = append(secretProperties, map[string]interface{}{
+			"content_type": contentType,
+		})
+	}
+
+	x509CertificateProperties := make([]interface{}, 0)
+	if props := input.X509CertificateProperties; props != nil {
+		extendedKeyUsage := make([]interface{}, 0)
+		if props.Ekus != nil {
+			for _, usage := range *props.Ekus {
+				extendedKeyUsage = append(extendedKeyUsage, usage)
+			}
+		}
+
+		keyUsage := make([]interface{}, 0)
+		if props.KeyUsage != nil {
+			for _, usage := range *props.KeyUsage {
+				keyUsage = append(keyUsage, string(usage))
+			}
+		}
+
+		subject := ""
+		if props.Subject != nil {
+			subject = *props.Subject
+		}
+
+		validityInMonths := 0
+		if props.ValidityInMonths != nil {
+			validityInMonths = int(*props.ValidityInMonths)
+		}
+
+		subjectAlternativeNames := make([]interface{}, 0)
+		if sans := props.SubjectAlternativeNames; sans != nil {
+			subjectAlternativeNames = append(subjectAlternativeNames, map[string]interface{}{
+				"dns_names": utils.FlattenStringSlice(sans.DNSNames),
+				"emails":    utils.FlattenStringSlice(sans.Emails),
+				"upns":      utils.FlattenStringSlice(sans.Upns),
+			})
+		}
+
+		x509CertificateProperties = append(x509CertificateProperties, map[string]interface{}{
+			"extended_key_usage":        extendedKeyUsage,
+			"key_usage":                 keyUsage,
+			"subject":                   subject,
+			"subject_alternative_names": subjectAlternativeNames,
+			"validity_in_months":        validityInMonths,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"issuer_parameters":           issuerParameters,
+			"key_properties":              keyProperties,
+			"lifetime_action":             lifetimeActions,
+			"secret_properties":           secretProperties,
+			"x509_certificate_properties": x509CertificateProperties,
+		},
+	}
+}
+
+func flattenKeyVaultCertificateAttributes(input *keyvault.CertificateAttributes) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	created := ""
+	if input.Created != nil {
+		created = time.Time(*input.Created).Format(time.RFC3339)
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	expires := ""
+	if input.Expires != nil {
+		expires = time.Time(*input.Expires).Format(time.RFC3339)
+	}
+
+	notBefore := ""
+	if input.NotBefore != nil {
+		notBefore = time.Time(*input.NotBefore).Format(time.RFC3339)
+	}
+
+	updated := ""
+	if input.Updated != nil {
+		updated = time.Time(*input.Updated).Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"created":        created,
+			"enabled":        enabled,
+			"expires":        expires,
+			"not_before":     notBefore,
+			"recovery_level": string(input.RecoveryLevel),
+			"updated":        updated,
+		},
+	}
+}
+
+var _ deleteAndPurgeNestedItem = deleteAndPurgeCertificate{}
+
+type deleteAndPurgeCertificate struct {
+	client      *keyvault.BaseClient
+	keyVaultUri string
+	name        string
+}
+
+func (d deleteAndPurgeCertificate) DeleteNestedItem(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.DeleteCertificate(ctx, d.keyVaultUri, d.name)
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeCertificate) NestedItemHasBeenDeleted(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetCertificate(ctx, d.keyVaultUri, d.name, "")
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeCertificate) PurgeNestedItem(ctx context.Context) (autorest.Response, error) {
+	return d.client.PurgeDeletedCertificate(ctx, d.keyVaultUri, d.name)
+}
+
+func (d deleteAndPurgeCertificate) NestedItemHasBeenPurged(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetDeletedCertificate(ctx, d.keyVaultUri, d.name)
+	return resp.Response, err
+}
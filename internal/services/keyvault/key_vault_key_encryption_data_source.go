@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func keyVaultKeyEncryptionDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyVaultKeyEncryptionDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(keyVaultKeyEncryptionAlgorithms, false),
+			},
+
+			"plaintext": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: keyVaultValidate.Base64,
+			},
+
+			"ciphertext": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func keyVaultKeyEncryptionDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyId := d.Get("key_vault_key_id").(string)
+	algorithm := d.Get("algorithm").(string)
+	id, result, err := performKeyVaultKeyOperation(ctx, meta, keyId, algorithm, d.Get("plaintext").(string), "Encrypt", client.Encrypt)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("ciphertext", result)
+	d.Set("version", id.Version)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type KeyVaultKeyEncryptionDataSource struct{}
+
+func TestAccKeyVaultKeyEncryptionDataSource_roundTrip(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_key_decryption", "test")
+	r := KeyVaultKeyEncryptionDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.roundTrip(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("data.azurestack_key_vault_key_encryption.test").Key("ciphertext").Exists(),
+				check.That("data.azurestack_key_vault_key_encryption.test").Key("version").Exists(),
+				check.That(data.ResourceName).Key("plaintext").HasValue("aGVsbG8gd29ybGQ="),
+			),
+		},
+	})
+}
+
+func (KeyVaultKeyEncryptionDataSource) roundTrip(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_key_vault_key_encryption" "test" {
+  key_vault_key_id = azurestack_key_vault_key.test.id
+  algorithm        = "RSA-OAEP"
+  plaintext        = base64encode("hello world")
+}
+
+data "azurestack_key_vault_key_decryption" "test" {
+  key_vault_key_id = azurestack_key_vault_key.test.id
+  algorithm        = "RSA-OAEP"
+  ciphertext       = data.azurestack_key_vault_key_encryption.test.ciphertext
+}
+`, KeyVaultKeyResource{}.basicRSA(data))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// expandKeyVaultKeyMaterial converts either a JSON Web Key or a PEM encoded RSA/EC private key
+// into the JsonWebKey structure used to import the key into Key Vault
+func expandKeyVaultKeyMaterial(input string) (*keyvault.JSONWebKey, error) {
+	input = strings.TrimSpace(input)
+
+	if strings.HasPrefix(input, "{") {
+		return expandKeyVaultKeyMaterialFromJwk(input)
+	}
+
+	block, _ := pem.Decode([]byte(input))
+	if block == nil {
+		return nil, fmt.Errorf("expected either a JSON Web Key or a PEM encoded private key")
+	}
+
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q - expected `RSA PRIVATE KEY`, `EC PRIVATE KEY` or `PRIVATE KEY`", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing the %q PEM block: %+v", block.Type, err)
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return expandKeyVaultKeyMaterialFromRsa(key), nil
+	case *ecdsa.PrivateKey:
+		return expandKeyVaultKeyMaterialFromEc(key)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T - only RSA and EC keys can be imported", privateKey)
+	}
+}
+
+func expandKeyVaultKeyMaterialFromJwk(input string) (*keyvault.JSONWebKey, error) {
+	var key keyvault.JSONWebKey
+	if err := json.Unmarshal([]byte(input), &key); err != nil {
+		return nil, fmt.Errorf("parsing the JSON Web Key: %+v", err)
+	}
+
+	switch key.Kty {
+	case keyvault.RSA, keyvault.RSAHSM:
+		if key.N == nil || key.E == nil || key.D == nil {
+			return nil, fmt.Errorf("an RSA JSON Web Key must contain the private key - `n`, `e` and `d` must be specified")
+		}
+	case keyvault.EC, keyvault.ECHSM:
+		if key.Crv == "" || key.X == nil || key.Y == nil || key.D == nil {
+			return nil, fmt.Errorf("an EC JSON Web Key must contain the private key - `crv`, `x`, `y` and `d` must be specified")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported JSON Web Key type %q - only RSA and EC keys can be imported", string(key.Kty))
+	}
+
+	// the Key Identifier is assigned by Key Vault
+	key.Kid = nil
+
+	return &key, nil
+}
+
+func expandKeyVaultKeyMaterialFromRsa(key *rsa.PrivateKey) *keyvault.JSONWebKey {
+	key.Precompute()
+
+	return &keyvault.JSONWebKey{
+		Kty: keyvault.RSA,
+		N:   keyVaultKeyMaterialEncode(key.N.Bytes()),
+		E:   keyVaultKeyMaterialEncode(big.NewInt(int64(key.E)).Bytes()),
+		D:   keyVaultKeyMaterialEncode(key.D.Bytes()),
+		P:   keyVaultKeyMaterialEncode(key.Primes[0].Bytes()),
+		Q:   keyVaultKeyMaterialEncode(key.Primes[1].Bytes()),
+		DP:  keyVaultKeyMaterialEncode(key.Precomputed.Dp.Bytes()),
+		DQ:  keyVaultKeyMaterialEncode(key.Precomputed.Dq.Bytes()),
+		QI:  keyVaultKeyMaterialEncode(key.Precomputed.Qinv.Bytes()),
+	}
+}
+
+func expandKeyVaultKeyMaterialFromEc(key *ecdsa.PrivateKey) (*keyvault.JSONWebKey, error) {
+	var curve keyvault.JSONWebKeyCurveName
+	switch key.Curve {
+	case elliptic.P256():
+		curve = keyvault.P256
+	case elliptic.P384():
+		curve = keyvault.P384
+	case elliptic.P521():
+		curve = keyvault.P521
+	default:
+		return nil, fmt.Errorf("unsupported elliptic curve %q - expected P-256, P-384 or P-521", key.Curve.Params().Name)
+	}
+
+	// the coordinates and private key are encoded using the full size of the curve
+	size := (key.Curve.Params().BitSize + 7) / 8
+
+	return &keyvault.JSONWebKey{
+		Kty: keyvault.EC,
+		Crv: curve,
+		X:   keyVaultKeyMaterialEncode(key.X.FillBytes(make([]byte, size))),
+		Y:   keyVaultKeyMaterialEncode(key.Y.FillBytes(make([]byte, size))),
+		D:   keyVaultKeyMaterialEncode(key.D.FillBytes(make([]byte, size))),
+	}, nil
+}
+
+func keyVaultKeyMaterialEncode(input []byte) *string {
+	return utils.String(base64.RawURLEncoding.EncodeToString(input))
+}
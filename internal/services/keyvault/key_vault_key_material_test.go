@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+)
+
+func TestExpandKeyVaultKeyMaterialRSA(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %+v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshalling RSA key: %+v", err)
+	}
+
+	inputs := map[string]string{
+		"PKCS1": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"PKCS8": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+	}
+
+	for name, input := range inputs {
+		t.Logf("[DEBUG] Testing %q", name)
+
+		actual, err := expandKeyVaultKeyMaterial(input)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual.Kty != keyvault.RSA {
+			t.Fatalf("expected Kty to be %q but got %q", keyvault.RSA, actual.Kty)
+		}
+		if decodeKeyVaultKeyMaterialForTest(t, actual.N).Cmp(privateKey.N) != 0 {
+			t.Fatalf("expected N to match the private key")
+		}
+		if decodeKeyVaultKeyMaterialForTest(t, actual.E).Int64() != int64(privateKey.E) {
+			t.Fatalf("expected E to match the private key")
+		}
+		if decodeKeyVaultKeyMaterialForTest(t, actual.D).Cmp(privateKey.D) != 0 {
+			t.Fatalf("expected D to match the private key")
+		}
+		if actual.P == nil || actual.Q == nil || actual.DP == nil || actual.DQ == nil || actual.QI == nil {
+			t.Fatalf("expected the CRT parameters to be populated")
+		}
+	}
+}
+
+func TestExpandKeyVaultKeyMaterialEC(t *testing.T) {
+	curves := map[keyvault.JSONWebKeyCurveName]elliptic.Curve{
+		keyvault.P256: elliptic.P256(),
+		keyvault.P384: elliptic.P384(),
+		keyvault.P521: elliptic.P521(),
+	}
+
+	for curveName, curve := range curves {
+		t.Logf("[DEBUG] Testing %q", curveName)
+
+		privateKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			t.Fatalf("generating EC key: %+v", err)
+		}
+		sec1, err := x509.MarshalECPrivateKey(privateKey)
+		if err != nil {
+			t.Fatalf("marshalling EC key: %+v", err)
+		}
+
+		actual, err := expandKeyVaultKeyMaterial(string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1})))
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual.Kty != keyvault.EC {
+			t.Fatalf("expected Kty to be %q but got %q", keyvault.EC, actual.Kty)
+		}
+		if actual.Crv != curveName {
+			t.Fatalf("expected Crv to be %q but got %q", curveName, actual.Crv)
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+		for _, v := range []*string{actual.X, actual.Y, actual.D} {
+			if v == nil {
+				t.Fatalf("expected X, Y and D to be populated")
+			}
+			decoded, err := base64.RawURLEncoding.DecodeString(*v)
+			if err != nil {
+				t.Fatalf("decoding value: %+v", err)
+			}
+			if len(decoded) != size {
+				t.Fatalf("expected the value to be padded to %d bytes but got %d", size, len(decoded))
+			}
+		}
+		if decodeKeyVaultKeyMaterialForTest(t, actual.D).Cmp(privateKey.D) != 0 {
+			t.Fatalf("expected D to match the private key")
+		}
+	}
+}
+
+func TestExpandKeyVaultKeyMaterialJWK(t *testing.T) {
+	testData := []struct {
+		Input string
+		Error bool
+	}{
+		{
+			// RSA private key
+			Input: `{"kty":"RSA","kid":"https://example.vault/keys/test","n":"AQAB","e":"AQAB","d":"AQAB"}`,
+			Error: false,
+		},
+		{
+			// EC private key
+			Input: `{"kty":"EC","crv":"P-256","x":"AQAB","y":"AQAB","d":"AQAB"}`,
+			Error: false,
+		},
+		{
+			// RSA public key only
+			Input: `{"kty":"RSA","n":"AQAB","e":"AQAB"}`,
+			Error: true,
+		},
+		{
+			// EC public key only
+			Input: `{"kty":"EC","crv":"P-256","x":"AQAB","y":"AQAB"}`,
+			Error: true,
+		},
+		{
+			// symmetric keys aren't supported
+			Input: `{"kty":"oct","k":"AQAB"}`,
+			Error: true,
+		},
+		{
+			// invalid JSON
+			Input: `{"kty":`,
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := expandKeyVaultKeyMaterial(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual.Kid != nil {
+			t.Fatalf("expected Kid to be removed but got %q", *actual.Kid)
+		}
+	}
+}
+
+func TestExpandKeyVaultKeyMaterialInvalid(t *testing.T) {
+	inputs := []string{
+		"",
+		"not a key",
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("hello")})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("hello")})),
+	}
+
+	for _, input := range inputs {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		if _, err := expandKeyVaultKeyMaterial(input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func decodeKeyVaultKeyMaterialForTest(t *testing.T, input *string) *big.Int {
+	if input == nil {
+		t.Fatalf("expected a value but got nil")
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(*input)
+	if err != nil {
+		t.Fatalf("decoding value: %+v", err)
+	}
+	return new(big.Int).SetBytes(decoded)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// keyVaultKeyEncryptionAlgorithms are the algorithms supported for Encrypt, Decrypt, WrapKey and UnwrapKey
+var keyVaultKeyEncryptionAlgorithms = []string{
+	string(keyvault.RSA15),
+	string(keyvault.RSAOAEP),
+	string(keyvault.RSAOAEP256),
+}
+
+// keyVaultKeySignatureAlgorithms are the algorithms supported for Sign and Verify
+var keyVaultKeySignatureAlgorithms = []string{
+	string(keyvault.ECDSA256),
+	string(keyvault.ES256),
+	string(keyvault.ES384),
+	string(keyvault.ES512),
+	string(keyvault.PS256),
+	string(keyvault.PS384),
+	string(keyvault.PS512),
+	string(keyvault.RS256),
+	string(keyvault.RS384),
+	string(keyvault.RS512),
+	string(keyvault.RSNULL),
+}
+
+// getKeyVaultKeyForOperation retrieves the Key used for a cryptographic operation, returning the ID of the
+// specific version which was retrieved, so that the operation uses the same version that was validated
+func getKeyVaultKeyForOperation(ctx context.Context, meta interface{}, keyId string) (*parse.NestedItemId, *keyvault.JSONWebKey, error) {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(keyId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil, fmt.Errorf("Key %q was not found in Key Vault at URI %q", id.Name, id.KeyVaultBaseUrl)
+		}
+		return nil, nil, fmt.Errorf("retrieving Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if resp.Key == nil || resp.Key.Kid == nil {
+		return nil, nil, fmt.Errorf("retrieving Key %q (Key Vault %q): `key.kid` was nil", id.Name, id.KeyVaultBaseUrl)
+	}
+
+	versionedId, err := parse.ParseNestedItemID(*resp.Key.Kid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return versionedId, resp.Key, nil
+}
+
+// validateKeyVaultKeyEncryptionAlgorithm validates that the Encryption Algorithm can be used with the Key
+func validateKeyVaultKeyEncryptionAlgorithm(key *keyvault.JSONWebKey, algorithm string) error {
+	switch key.Kty {
+	case keyvault.RSA, keyvault.RSAHSM:
+		return nil
+	default:
+		return fmt.Errorf("the algorithm %q can only be used with an `RSA` Key but the Key is of type %q", algorithm, string(key.Kty))
+	}
+}
+
+// validateKeyVaultKeySignatureAlgorithm validates that the Signature Algorithm can be used with the Key and that
+// the digest is the length expected by the algorithm
+func validateKeyVaultKeySignatureAlgorithm(key *keyvault.JSONWebKey, algorithm string, digest []byte) error {
+	var curve keyvault.JSONWebKeyCurveName
+	var digestLength int
+
+	switch keyvault.JSONWebKeySignatureAlgorithm(algorithm) {
+	case keyvault.RS256, keyvault.PS256:
+		digestLength = 32
+	case keyvault.RS384, keyvault.PS384:
+		digestLength = 48
+	case keyvault.RS512, keyvault.PS512:
+		digestLength = 64
+	case keyvault.RSNULL:
+		// the digest is signed as-is
+	case keyvault.ES256:
+		curve, digestLength = keyvault.P256, 32
+	case keyvault.ES384:
+		curve, digestLength = keyvault.P384, 48
+	case keyvault.ES512:
+		curve, digestLength = keyvault.P521, 64
+	case keyvault.ECDSA256:
+		curve, digestLength = keyvault.SECP256K1, 32
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+
+	isEcKey := key.Kty == keyvault.EC || key.Kty == keyvault.ECHSM
+	isRsaKey := key.Kty == keyvault.RSA || key.Kty == keyvault.RSAHSM
+
+	if curve == "" {
+		if !isRsaKey {
+			return fmt.Errorf("the algorithm %q can only be used with an `RSA` Key but the Key is of type %q", algorithm, string(key.Kty))
+		}
+	} else {
+		if !isEcKey {
+			return fmt.Errorf("the algorithm %q can only be used with an `EC` Key but the Key is of type %q", algorithm, string(key.Kty))
+		}
+		if key.Crv != curve {
+			return fmt.Errorf("the algorithm %q can only be used with an `EC` Key using the curve %q but the Key uses the curve %q", algorithm, string(curve), string(key.Crv))
+		}
+	}
+
+	if digestLength > 0 && len(digest) != digestLength {
+		return fmt.Errorf("the algorithm %q expects a digest of %d bytes but got %d bytes", algorithm, digestLength, len(digest))
+	}
+
+	return nil
+}
+
+// decodeKeyVaultKeyOperationValue decodes a base64 encoded value, accepting both the standard and URL encodings
+// with or without padding
+func decodeKeyVaultKeyOperationValue(input string) ([]byte, error) {
+	input = strings.TrimRight(strings.TrimSpace(input), "=")
+	input = strings.NewReplacer("+", "-", "/", "_").Replace(input)
+
+	return base64.RawURLEncoding.DecodeString(input)
+}
+
+// encodeKeyVaultKeyOperationValue encodes a value using the base64url encoding used by the Key Vault API
+func encodeKeyVaultKeyOperationValue(input []byte) *string {
+	return utils.String(base64.RawURLEncoding.EncodeToString(input))
+}
+
+// flattenKeyVaultKeyOperationResult converts the base64url encoded value returned from the Key Vault API into the
+// standard base64 encoding, which can be used with Terraform's `base64decode` function
+func flattenKeyVaultKeyOperationResult(input *string) (string, error) {
+	if input == nil {
+		return "", fmt.Errorf("the result was nil")
+	}
+
+	decoded, err := decodeKeyVaultKeyOperationValue(*input)
+	if err != nil {
+		return "", fmt.Errorf("decoding the result: %+v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(decoded), nil
+}
+
+type keyVaultKeyOperationFunc func(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string, parameters keyvault.KeyOperationsParameters) (keyvault.KeyOperationResult, error)
+
+// performKeyVaultKeyOperation validates the algorithm against the Key and then performs the Encrypt, Decrypt,
+// WrapKey or UnwrapKey operation, returning the ID of the Key Version used and the standard base64 encoded result
+func performKeyVaultKeyOperation(ctx context.Context, meta interface{}, keyId, algorithm, value, description string, operation keyVaultKeyOperationFunc) (*parse.NestedItemId, string, error) {
+	decoded, err := decodeKeyVaultKeyOperationValue(value)
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding the value to %s: %+v", description, err)
+	}
+
+	id, key, err := getKeyVaultKeyForOperation(ctx, meta, keyId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := validateKeyVaultKeyEncryptionAlgorithm(key, algorithm); err != nil {
+		return nil, "", err
+	}
+
+	parameters := keyvault.KeyOperationsParameters{
+		Algorithm: keyvault.JSONWebKeyEncryptionAlgorithm(algorithm),
+		Value:     encodeKeyVaultKeyOperationValue(decoded),
+	}
+	resp, err := operation(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters)
+	if err != nil {
+		return nil, "", fmt.Errorf("performing %s using Key %q (Key Vault %q): %+v", description, id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	result, err := flattenKeyVaultKeyOperationResult(resp.Result)
+	if err != nil {
+		return nil, "", fmt.Errorf("performing %s using Key %q (Key Vault %q): %+v", description, id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return id, result, nil
+}
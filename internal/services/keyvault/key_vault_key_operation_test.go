@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func TestValidateKeyVaultKeyEncryptionAlgorithm(t *testing.T) {
+	testData := []struct {
+		KeyType keyvault.JSONWebKeyType
+		Error   bool
+	}{
+		{
+			KeyType: keyvault.RSA,
+			Error:   false,
+		},
+		{
+			KeyType: keyvault.RSAHSM,
+			Error:   false,
+		},
+		{
+			KeyType: keyvault.EC,
+			Error:   true,
+		},
+		{
+			KeyType: keyvault.ECHSM,
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.KeyType)
+
+		err := validateKeyVaultKeyEncryptionAlgorithm(&keyvault.JSONWebKey{Kty: v.KeyType}, string(keyvault.RSAOAEP))
+		if (err != nil) != v.Error {
+			t.Fatalf("expected an error to be %t but got: %+v", v.Error, err)
+		}
+	}
+}
+
+func TestValidateKeyVaultKeySignatureAlgorithm(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Key       keyvault.JSONWebKey
+		Algorithm keyvault.JSONWebKeySignatureAlgorithm
+		Digest    int
+		Error     bool
+	}{
+		{
+			Name:      "RSA with RS256",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.RSA},
+			Algorithm: keyvault.RS256,
+			Digest:    32,
+			Error:     false,
+		},
+		{
+			Name:      "RSA with PS512",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.RSA},
+			Algorithm: keyvault.PS512,
+			Digest:    64,
+			Error:     false,
+		},
+		{
+			Name:      "RSA with RSNULL of any length",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.RSA},
+			Algorithm: keyvault.RSNULL,
+			Digest:    20,
+			Error:     false,
+		},
+		{
+			Name:      "RSA with the wrong digest length",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.RSA},
+			Algorithm: keyvault.RS384,
+			Digest:    32,
+			Error:     true,
+		},
+		{
+			Name:      "RSA with ES256",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.RSA},
+			Algorithm: keyvault.ES256,
+			Digest:    32,
+			Error:     true,
+		},
+		{
+			Name:      "EC P-256 with ES256",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.EC, Crv: keyvault.P256},
+			Algorithm: keyvault.ES256,
+			Digest:    32,
+			Error:     false,
+		},
+		{
+			Name:      "EC P-521 with ES512",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.EC, Crv: keyvault.P521},
+			Algorithm: keyvault.ES512,
+			Digest:    64,
+			Error:     false,
+		},
+		{
+			Name:      "EC SECP256K1 with ECDSA256",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.EC, Crv: keyvault.SECP256K1},
+			Algorithm: keyvault.ECDSA256,
+			Digest:    32,
+			Error:     false,
+		},
+		{
+			Name:      "EC P-384 with ES256",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.EC, Crv: keyvault.P384},
+			Algorithm: keyvault.ES256,
+			Digest:    32,
+			Error:     true,
+		},
+		{
+			Name:      "EC with RS256",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.EC, Crv: keyvault.P256},
+			Algorithm: keyvault.RS256,
+			Digest:    32,
+			Error:     true,
+		},
+		{
+			Name:      "unsupported algorithm",
+			Key:       keyvault.JSONWebKey{Kty: keyvault.RSA},
+			Algorithm: keyvault.JSONWebKeySignatureAlgorithm("HS256"),
+			Digest:    32,
+			Error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		key := v.Key
+		err := validateKeyVaultKeySignatureAlgorithm(&key, string(v.Algorithm), make([]byte, v.Digest))
+		if (err != nil) != v.Error {
+			t.Fatalf("expected an error to be %t but got: %+v", v.Error, err)
+		}
+	}
+}
+
+func TestFlattenKeyVaultKeyOperationResult(t *testing.T) {
+	testData := []struct {
+		Input    *string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: nil,
+			Error: true,
+		},
+		{
+			// base64url without padding, as returned by Key Vault
+			Input:    utils.String("-_-_aGVsbG8"),
+			Expected: "+/+/aGVsbG8=",
+		},
+		{
+			Input: utils.String("not base64!"),
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		actual, err := flattenKeyVaultKeyOperationResult(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+
+		// the result can be passed back into a subsequent operation
+		decoded, err := decodeKeyVaultKeyOperationValue(actual)
+		if err != nil {
+			t.Fatalf("decoding %q: %+v", actual, err)
+		}
+		if *encodeKeyVaultKeyOperationValue(decoded) != *v.Input {
+			t.Fatalf("expected the value to round-trip to %q but got %q", *v.Input, *encodeKeyVaultKeyOperationValue(decoded))
+		}
+	}
+}
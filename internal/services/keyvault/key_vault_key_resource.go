@@ -42,6 +42,8 @@ func keyVaultKey() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: keyVaultNestedItemRotationCustomizeDiff("n", "e", "x", "y"),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -58,9 +60,11 @@ func keyVaultKey() *schema.Resource {
 			},
 
 			"key_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"key_type", "key_material"},
 				// turns out Azure's *really* sensitive about the casing of these
 				// issue: https://github.com/Azure/azure-rest-api-specs/issues/1739
 				ValidateFunc: validation.StringInSlice([]string{
@@ -76,8 +80,28 @@ func keyVaultKey() *schema.Resource {
 			"key_size": {
 				Type:          schema.TypeInt,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"curve"},
+				ConflictsWith: []string{"curve", "key_material"},
+			},
+
+			// `key_material` is only used when the Key is created and isn't returned by the API, so it's write-only
+			// to avoid storing the private key in the state - which also means importing the Key doesn't recreate it
+			"key_material": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"rotation"},
+			},
+
+			"hsm": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"key_material"},
 			},
 
 			"key_opts": {
@@ -112,7 +136,7 @@ func keyVaultKey() *schema.Resource {
 				// TODO: the curve name should probably be mandatory for EC in the future,
 				// but handle the diff so that we don't break existing configurations and
 				// imported EC keys
-				ConflictsWith: []string{"key_size"},
+				ConflictsWith: []string{"key_size", "key_material"},
 			},
 
 			"not_before_date": {
@@ -122,17 +146,38 @@ func keyVaultKey() *schema.Resource {
 			},
 
 			"expiration_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: keyVaultNestedItemExpirationDateDiffSuppress,
 			},
 
+			"rotation": keyVaultNestedItemRotationSchema(false),
+
 			// Computed
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"version_created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled_previous_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"versionless_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -192,49 +237,78 @@ func keyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurestack_key_vault_key", *existing.Key.Kid)
 	}
 
-	keyType := d.Get("key_type").(string)
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
-	// TODO: support Importing Keys once this is fixed:
-	// https://github.com/Azure/azure-rest-api-specs/issues/1747
-	parameters := keyvault.KeyCreateParameters{
-		Kty:    keyvault.JSONWebKeyType(keyType),
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-
-		Tags: tags.Expand(t),
+	attributes, err := expandKeyVaultKeyAttributes(d, true)
+	if err != nil {
+		return err
 	}
 
-	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
-		curveName := d.Get("curve").(string)
-		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
-	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
-		keySize, ok := d.GetOk("key_size")
-		if !ok {
-			return fmt.Errorf("Key size is required when creating an RSA key")
+	var createKey func() (autorest.Response, error)
+	if keyMaterial := d.GetRawConfig().GetAttr("key_material"); !keyMaterial.IsNull() && keyMaterial.AsString() != "" {
+		key, err := expandKeyVaultKeyMaterial(keyMaterial.AsString())
+		if err != nil {
+			return fmt.Errorf("parsing `key_material` for Key %q: %+v", name, err)
 		}
-		parameters.KeySize = utils.Int32(int32(keySize.(int)))
-	}
-	// TODO: support `oct` once this is fixed
-	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
+		keyOps := make([]string, 0, len(*keyOptions))
+		for _, option := range *keyOptions {
+			keyOps = append(keyOps, string(option))
+		}
+		key.KeyOps = &keyOps
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
-	}
+		parameters := keyvault.KeyImportParameters{
+			Hsm:           utils.Bool(d.Get("hsm").(bool)),
+			Key:           key,
+			KeyAttributes: attributes,
+			Tags:          tags.Expand(t),
+		}
+		createKey = func() (autorest.Response, error) {
+			resp, err := client.ImportKey(ctx, *keyVaultBaseUri, name, parameters)
+			if err != nil {
+				return resp.Response, fmt.Errorf("Error Importing Key: %+v", err)
+			}
+			return resp.Response, nil
+		}
+	} else {
+		parameters, err := expandKeyVaultKeyCreateParameters(d, attributes)
+		if err != nil {
+			return err
+		}
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
+		createKey = func() (autorest.Response, error) {
+			resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters)
+			if err != nil {
+				return resp.Response, fmt.Errorf("Error Creating Key: %+v", err)
+			}
+			return resp.Response, nil
+		}
 	}
 
-	if _, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
-		return fmt.Errorf("Error Creating Key: %+v", err)
+	if resp, err := createKey(); err != nil {
+		// a Conflict is returned when a soft-deleted Key with the same name exists
+		if !utils.ResponseWasConflict(resp) {
+			return err
+		}
+
+		description := fmt.Sprintf("Key %q (Key Vault %q)", name, *keyVaultBaseUri)
+		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeys {
+			return softDeletedNestedItemExistsError(description, "recover_soft_deleted_keys")
+		}
+
+		recoverer := deleteAndPurgeKey{
+			client:      client,
+			keyVaultUri: *keyVaultBaseUri,
+			name:        name,
+		}
+		if err := recoverSoftDeletedNestedItem(ctx, description, recoverer); err != nil {
+			return err
+		}
+
+		// creating the Key again creates a new version using the configured values
+		if _, err := createKey(); err != nil {
+			return err
+		}
 	}
 
 	// "" indicates the latest version
@@ -283,27 +357,56 @@ func keyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	keyOptions := expandKeyVaultKeyOptions(d)
-	t := d.Get("tags").(map[string]interface{})
+	now := time.Now()
+	rotate, err := keyVaultNestedItemRotationIsDue(d, now)
+	if err != nil {
+		return err
+	}
+	disablePreviousVersions, err := keyVaultNestedItemPreviousVersionsDisableIsDue(d, now)
+	if err != nil {
+		return err
+	}
 
-	parameters := keyvault.KeyUpdateParameters{
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-		Tags: tags.Expand(t),
+	// previous versions are disabled before rotating, so that the grace period applies to the current version
+	if disablePreviousVersions {
+		if err := disablePreviousKeyVaultKeyVersions(ctx, client, id.KeyVaultBaseUrl, id.Name, id.Version); err != nil {
+			return err
+		}
 	}
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+	attributes, err := expandKeyVaultKeyAttributes(d, rotate)
+	if err != nil {
+		return err
 	}
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
+	if rotate {
+		// creating the Key again creates a new version using the same key type, size and curve
+		parameters, err := expandKeyVaultKeyCreateParameters(d, attributes)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.CreateKey(ctx, id.KeyVaultBaseUrl, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("rotating Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		if resp.Key == nil || resp.Key.Kid == nil {
+			return fmt.Errorf("rotating Key %q (Key Vault %q): `key.kid` was nil", id.Name, id.KeyVaultBaseUrl)
+		}
+
+		// the ID is suffixed with the key version
+		d.SetId(*resp.Key.Kid)
+
+		return keyVaultKeyRead(d, meta)
+	}
+
+	keyOptions := expandKeyVaultKeyOptions(d)
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := keyvault.KeyUpdateParameters{
+		KeyOps:        keyOptions,
+		KeyAttributes: attributes,
+		Tags:          tags.Expand(t),
 	}
 
 	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
@@ -385,6 +488,7 @@ func keyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("curve", key.Crv)
 	}
 
+	var created *date.UnixTime
 	if attributes := resp.Attributes; attributes != nil {
 		if v := attributes.NotBefore; v != nil {
 			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
@@ -393,15 +497,117 @@ func keyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 		if v := attributes.Expires; v != nil {
 			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
 		}
+
+		created = attributes.Created
 	}
 
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.NestedItemType, id.Name))
 
+	versionCreatedDate, nextRotationDate, err := flattenKeyVaultNestedItemRotationDates(d, created)
+	if err != nil {
+		return err
+	}
+	d.Set("version_created_date", versionCreatedDate)
+	d.Set("next_rotation_date", nextRotationDate)
+
+	enabledPreviousVersions := make([]interface{}, 0)
+	if rotation, _ := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{})); rotation != nil && rotation.DisablePreviousAfter != nil {
+		versions, err := listKeyVaultKeyVersions(ctx, client, id.KeyVaultBaseUrl, id.Name)
+		if err != nil {
+			return err
+		}
+		enabledPreviousVersions = enabledPreviousKeyVaultNestedItemVersions(versions, id.Version)
+	}
+	if err := d.Set("enabled_previous_versions", enabledPreviousVersions); err != nil {
+		return fmt.Errorf("setting `enabled_previous_versions`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+// expandKeyVaultKeyAttributes expands the attributes of a Key, where `newVersion` calculates the expiration date
+// of the new version from `expire_after` within the `rotation` block when it's set
+func expandKeyVaultKeyAttributes(d *schema.ResourceData, newVersion bool) (*keyvault.KeyAttributes, error) {
+	attributes := &keyvault.KeyAttributes{
+		Enabled: utils.Bool(true),
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		attributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	if newVersion {
+		rotation, err := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		if rotation != nil && rotation.ExpireAfter != nil {
+			attributes.Expires = rotation.expirationDate(time.Now())
+		}
+	}
+
+	return attributes, nil
+}
+
+func expandKeyVaultKeyCreateParameters(d *schema.ResourceData, attributes *keyvault.KeyAttributes) (keyvault.KeyCreateParameters, error) {
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := keyvault.KeyCreateParameters{
+		Kty:           keyvault.JSONWebKeyType(d.Get("key_type").(string)),
+		KeyOps:        expandKeyVaultKeyOptions(d),
+		KeyAttributes: attributes,
+
+		Tags: tags.Expand(t),
+	}
+
+	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
+		curveName := d.Get("curve").(string)
+		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
+	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return parameters, fmt.Errorf("Key size is required when creating an RSA key")
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	}
+	// TODO: support `oct` once this is fixed
+	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
+
+	return parameters, nil
+}
+
+// disablePreviousKeyVaultKeyVersions disables each enabled version of the Key other than the current version
+func disablePreviousKeyVaultKeyVersions(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl, name, currentVersion string) error {
+	versions, err := listKeyVaultKeyVersions(ctx, client, keyVaultBaseUrl, name)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range enabledPreviousKeyVaultNestedItemVersions(versions, currentVersion) {
+		log.Printf("[DEBUG] Disabling version %q of Key %q (Key Vault %q)", version, name, keyVaultBaseUrl)
+		parameters := keyvault.KeyUpdateParameters{
+			KeyAttributes: &keyvault.KeyAttributes{
+				Enabled: utils.Bool(false),
+			},
+		}
+		if _, err := client.UpdateKey(ctx, keyVaultBaseUrl, name, version.(string), parameters); err != nil {
+			return fmt.Errorf("disabling version %q of Key %q (Key Vault %q): %+v", version, name, keyVaultBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
 func keyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
@@ -436,8 +642,11 @@ func keyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	// shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy
-	shouldPurge := false
+	shouldPurge, err := shouldPurgeNestedItems(ctx, meta, *keyVaultId)
+	if err != nil {
+		return err
+	}
+
 	description := fmt.Sprintf("Key %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
 	deleter := deleteAndPurgeKey{
 		client:      client,
@@ -451,7 +660,10 @@ func keyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-var _ deleteAndPurgeNestedItem = deleteAndPurgeKey{}
+var (
+	_ deleteAndPurgeNestedItem = deleteAndPurgeKey{}
+	_ recoverNestedItem        = deleteAndPurgeKey{}
+)
 
 type deleteAndPurgeKey struct {
 	client      *keyvault.BaseClient
@@ -498,3 +710,13 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 
 	return results
 }
+
+func (d deleteAndPurgeKey) RecoverNestedItem(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.RecoverDeletedKey(ctx, d.keyVaultUri, d.name)
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeKey) NestedItemHasBeenRecovered(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetKey(ctx, d.keyVaultUri, d.name, "")
+	return resp.Response, err
+}
@@ -5,6 +5,12 @@ package keyvault_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"testing"
 	"time"
@@ -85,6 +91,40 @@ func TestAccKeyVaultKey_basicRSA(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_importRSA(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.importRSA(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_type").HasValue("RSA"),
+				check.That(data.ResourceName).Key("key_size").HasValue("2048"),
+			),
+		},
+		data.ImportStep("hsm"),
+	})
+}
+
+func TestAccKeyVaultKey_importEC(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.importEC(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_type").HasValue("EC"),
+				check.That(data.ResourceName).Key("curve").HasValue("P-256"),
+			),
+		},
+		data.ImportStep("hsm"),
+	})
+}
+
 func TestAccKeyVaultKey_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -156,6 +196,25 @@ func TestAccKeyVaultKey_updatedExternally(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.rotation(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version_created_date").IsSet(),
+				check.That(data.ResourceName).Key("next_rotation_date").IsSet(),
+				check.That(data.ResourceName).Key("expiration_date").IsSet(),
+				check.That(data.ResourceName).Key("enabled_previous_versions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("key_size", "rotation", "next_rotation_date"),
+	})
+}
+
 func TestAccKeyVaultKey_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -392,6 +451,104 @@ resource "azurestack_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) rotation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurestack_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+
+  rotation {
+    rotate_after           = "P30D"
+    expire_after           = "P90D"
+    disable_previous_after = "P7D"
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
+func (r KeyVaultKeyResource) importRSA(data acceptance.TestData) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(fmt.Sprintf("generating RSA key: %+v", err))
+	}
+	keyMaterial := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurestack_key_vault.test.id
+
+  key_material = <<EOT
+%sEOT
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "verify",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, string(keyMaterial))
+}
+
+func (r KeyVaultKeyResource) importEC(data acceptance.TestData) string {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(fmt.Sprintf("generating EC key: %+v", err))
+	}
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		panic(fmt.Sprintf("marshalling EC key: %+v", err))
+	}
+	keyMaterial := pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: privateKeyBytes,
+	})
+
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurestack_key_vault.test.id
+
+  key_material = <<EOT
+%sEOT
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, string(keyMaterial))
+}
+
 func (r KeyVaultKeyResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -624,11 +781,19 @@ resource "azurestack_key_vault" "test" {
 
     key_permissions = [
       "Create",
+      "Decrypt",
       "Delete",
+      "Encrypt",
       "Get",
+      "Import",
+      "List",
       "Purge",
       "Recover",
+      "Sign",
+      "UnwrapKey",
       "Update",
+      "Verify",
+      "WrapKey",
     ]
 
     secret_permissions = [
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type KeyVaultKeyRestoreResource struct{}
+
+func TestAccKeyVaultKeyRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_key_restore", "test")
+	r := KeyVaultKeyRestoreResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("versionless_id").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultKeyRestore_existingWithoutVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_key_restore", "test")
+	r := KeyVaultKeyRestoreResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.existingWithoutVersion(data),
+			ExpectError: regexp.MustCompile("an existing Key .* was found - `version` must be specified"),
+		},
+	})
+}
+
+func (KeyVaultKeyRestoreResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Key != nil), nil
+}
+
+func (r KeyVaultKeyRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_key" "source" {
+  name         = "key-%s"
+  key_vault_id = azurestack_key_vault.source.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+
+data "azurestack_key_vault_key_backup" "test" {
+  name         = azurestack_key_vault_key.source.name
+  key_vault_id = azurestack_key_vault.source.id
+}
+
+resource "azurestack_key_vault_key_restore" "test" {
+  name         = azurestack_key_vault_key.source.name
+  key_vault_id = azurestack_key_vault.target.id
+  backup       = data.azurestack_key_vault_key_backup.test.backup
+  version      = data.azurestack_key_vault_key_backup.test.version
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultKeyRestoreResource) existingWithoutVersion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_key_restore" "import" {
+  name         = azurestack_key_vault_key_restore.test.name
+  key_vault_id = azurestack_key_vault_key_restore.test.key_vault_id
+  backup       = azurestack_key_vault_key_restore.test.backup
+}
+`, r.basic(data))
+}
+
+func (KeyVaultKeyRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_key_vault" "source" {
+  name                = "acctestkvs-%s"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  tenant_id           = data.azurestack_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurestack_client_config.current.tenant_id
+    object_id = data.azurestack_client_config.current.service_principal_object_id
+
+    key_permissions = [
+      "Backup",
+      "Create",
+      "Delete",
+      "Get",
+      "Purge",
+    ]
+  }
+}
+
+resource "azurestack_key_vault" "target" {
+  name                = "acctestkvt-%s"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  tenant_id           = data.azurestack_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurestack_client_config.current.tenant_id
+    object_id = data.azurestack_client_config.current.service_principal_object_id
+
+    key_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Restore",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
+}
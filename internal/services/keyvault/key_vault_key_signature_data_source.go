@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func keyVaultKeySignatureDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyVaultKeySignatureDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(keyVaultKeySignatureAlgorithms, false),
+			},
+
+			"digest": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.Base64,
+			},
+
+			"signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func keyVaultKeySignatureDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	algorithm := d.Get("algorithm").(string)
+	digest, err := decodeKeyVaultKeyOperationValue(d.Get("digest").(string))
+	if err != nil {
+		return fmt.Errorf("decoding `digest`: %+v", err)
+	}
+
+	id, key, err := getKeyVaultKeyForOperation(ctx, meta, d.Get("key_vault_key_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := validateKeyVaultKeySignatureAlgorithm(key, algorithm, digest); err != nil {
+		return err
+	}
+
+	parameters := keyvault.KeySignParameters{
+		Algorithm: keyvault.JSONWebKeySignatureAlgorithm(algorithm),
+		Value:     encodeKeyVaultKeyOperationValue(digest),
+	}
+	resp, err := client.Sign(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters)
+	if err != nil {
+		return fmt.Errorf("signing using Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	signature, err := flattenKeyVaultKeyOperationResult(resp.Result)
+	if err != nil {
+		return fmt.Errorf("signing using Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("signature", signature)
+	d.Set("version", id.Version)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type KeyVaultKeySignatureDataSource struct{}
+
+func TestAccKeyVaultKeySignatureDataSource_rsa(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_key_verification", "test")
+	r := KeyVaultKeySignatureDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.signAndVerify(KeyVaultKeyResource{}.basicRSA(data), "RS256", "base64sha256"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("data.azurestack_key_vault_key_signature.test").Key("signature").Exists(),
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultKeySignatureDataSource_ec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_key_verification", "test")
+	r := KeyVaultKeySignatureDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.signAndVerify(KeyVaultKeyResource{}.curveEC(data), "ES512", "base64sha512"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("data.azurestack_key_vault_key_signature.test").Key("signature").Exists(),
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (KeyVaultKeySignatureDataSource) signAndVerify(template, algorithm, digestFunction string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_key_vault_key_signature" "test" {
+  key_vault_key_id = azurestack_key_vault_key.test.id
+  algorithm        = "%s"
+  digest           = %s("hello world")
+}
+
+data "azurestack_key_vault_key_verification" "test" {
+  key_vault_key_id = azurestack_key_vault_key.test.id
+  algorithm        = "%[2]s"
+  digest           = %[3]s("hello world")
+  signature        = data.azurestack_key_vault_key_signature.test.signature
+}
+`, template, algorithm, digestFunction)
+}
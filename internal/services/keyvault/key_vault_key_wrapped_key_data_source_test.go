@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type KeyVaultKeyWrappedKeyDataSource struct{}
+
+func TestAccKeyVaultKeyWrappedKeyDataSource_roundTrip(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_key_unwrapped_key", "test")
+	r := KeyVaultKeyWrappedKeyDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.roundTrip(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("data.azurestack_key_vault_key_wrapped_key.test").Key("wrapped_key").Exists(),
+				check.That(data.ResourceName).Key("key").HasValue("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="),
+			),
+		},
+	})
+}
+
+func (KeyVaultKeyWrappedKeyDataSource) roundTrip(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_key_vault_key_wrapped_key" "test" {
+  key_vault_key_id = azurestack_key_vault_key.test.id
+  algorithm        = "RSA-OAEP-256"
+  key              = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+}
+
+data "azurestack_key_vault_key_unwrapped_key" "test" {
+  key_vault_key_id = azurestack_key_vault_key.test.id
+  algorithm        = "RSA-OAEP-256"
+  wrapped_key      = data.azurestack_key_vault_key_wrapped_key.test.wrapped_key
+}
+`, KeyVaultKeyResource{}.basicRSA(data))
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func keyVaultKeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyVaultKeysDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"required_tags": tags.Schema(),
+
+			"include_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"not_before_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"expiration_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+
+						"versions": keyVaultNestedItemVersionsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func keyVaultKeysDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Keys vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+	includeVersions := d.Get("include_versions").(bool)
+
+	names := make([]interface{}, 0)
+	keys := make([]interface{}, 0)
+
+	resp, err := client.GetKeys(ctx, *keyVaultBaseUri, nil)
+	if err != nil {
+		return fmt.Errorf("listing Keys (Key Vault %q): %+v", *keyVaultBaseUri, err)
+	}
+
+	for resp.NotDone() {
+		for _, item := range resp.Values() {
+			if item.Kid == nil {
+				continue
+			}
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(*item.Kid)
+			if err != nil {
+				return err
+			}
+
+			if !keyVaultNestedItemMatchesFilter(*id, item.Tags, namePrefix, requiredTags) {
+				log.Printf("[DEBUG] Key %q (Key Vault %q) skipped as it doesn't match the filter", id.Name, *keyVaultBaseUri)
+				continue
+			}
+
+			key := flattenKeyVaultKeyItem(*id, item)
+			if includeVersions {
+				versions, err := listKeyVaultKeyVersions(ctx, client, *keyVaultBaseUri, id.Name)
+				if err != nil {
+					return err
+				}
+				key["versions"] = versions
+			}
+
+			names = append(names, id.Name)
+			keys = append(keys, key)
+		}
+
+		if err := resp.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Keys (Key Vault %q): %+v", *keyVaultBaseUri, err)
+		}
+	}
+
+	d.SetId(keyVaultId.ID())
+	d.Set("key_vault_id", keyVaultId.ID())
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("setting `names`: %+v", err)
+	}
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("setting `keys`: %+v", err)
+	}
+
+	return nil
+}
+
+func listKeyVaultKeyVersions(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUri string, name string) ([]interface{}, error) {
+	versions := make([]interface{}, 0)
+
+	resp, err := client.GetKeyVersions(ctx, keyVaultBaseUri, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing versions of Key %q (Key Vault %q): %+v", name, keyVaultBaseUri, err)
+	}
+
+	for resp.NotDone() {
+		for _, item := range resp.Values() {
+			if item.Kid == nil {
+				continue
+			}
+
+			id, err := parse.ParseNestedItemID(*item.Kid)
+			if err != nil {
+				return nil, err
+			}
+
+			var attributes keyvault.KeyAttributes
+			if item.Attributes != nil {
+				attributes = *item.Attributes
+			}
+			versions = append(versions, flattenKeyVaultNestedItemVersion(*id, attributes.Enabled, attributes.NotBefore, attributes.Expires))
+		}
+
+		if err := resp.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing versions of Key %q (Key Vault %q): %+v", name, keyVaultBaseUri, err)
+		}
+	}
+
+	return versions, nil
+}
+
+func flattenKeyVaultKeyItem(id parse.NestedItemId, item keyvault.KeyItem) map[string]interface{} {
+	var attributes keyvault.KeyAttributes
+	if item.Attributes != nil {
+		attributes = *item.Attributes
+	}
+	key := flattenKeyVaultNestedItemVersion(id, attributes.Enabled, attributes.NotBefore, attributes.Expires)
+	delete(key, "version")
+
+	key["name"] = id.Name
+	key["tags"] = tags.Flatten(item.Tags)
+	key["versions"] = make([]interface{}, 0)
+
+	return key
+}
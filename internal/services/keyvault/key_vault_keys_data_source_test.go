@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type KeyVaultKeysDataSource struct{}
+
+func TestAccKeyVaultKeysDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_keys", "test")
+	r := KeyVaultKeysDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("keys.#").HasValue("1"),
+				check.That(data.ResourceName).Key("keys.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("keys.0.versions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("keys.0.versions.0.version").Exists(),
+			),
+		},
+	})
+}
+
+func (KeyVaultKeysDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_key_vault_keys" "test" {
+  key_vault_id     = azurestack_key_vault.test.id
+  name_prefix      = azurestack_key_vault_key.test.name
+  include_versions = true
+}
+`, KeyVaultKeyResource{}.basicRSA(data))
+}
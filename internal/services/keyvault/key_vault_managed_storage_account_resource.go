@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func keyVaultManagedStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: keyVaultManagedStorageAccountCreate,
+		Read:   keyVaultManagedStorageAccountRead,
+		Update: keyVaultManagedStorageAccountUpdate,
+		Delete: keyVaultManagedStorageAccountDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedStorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: keyVaultManagedStorageAccountCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedStorageAccountName,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"storage_account_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"key1",
+					"key2",
+				}, false),
+			},
+
+			"regenerate_key_automatically": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"regeneration_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ISO8601Duration,
+			},
+
+			// the API has no representation of this, it's an arbitrary value which regenerates the active key when changed
+			"regenerate_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func keyVaultManagedStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Managed Storage Account %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	id, err := parse.NewManagedStorageAccountID(*keyVaultBaseUrl, name)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetStorageAccount(ctx, *keyVaultBaseUrl, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Managed Storage Account %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurestack_key_vault_managed_storage_account", id.ID())
+	}
+
+	regenerateKeyAutomatically := d.Get("regenerate_key_automatically").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+
+	parameters := keyvault.StorageAccountCreateParameters{
+		ResourceID:        utils.String(d.Get("storage_account_id").(string)),
+		ActiveKeyName:     utils.String(d.Get("storage_account_key").(string)),
+		AutoRegenerateKey: utils.Bool(regenerateKeyAutomatically),
+		StorageAccountAttributes: &keyvault.StorageAccountAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.SetStorageAccount(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("setting Managed Storage Account %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return keyVaultManagedStorageAccountRead(d, meta)
+}
+
+func keyVaultManagedStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	regenerateKeyAutomatically := d.Get("regenerate_key_automatically").(bool)
+	regenerationPeriod := d.Get("regeneration_period").(string)
+
+	parameters := keyvault.StorageAccountUpdateParameters{
+		ActiveKeyName:     utils.String(d.Get("storage_account_key").(string)),
+		AutoRegenerateKey: utils.Bool(regenerateKeyAutomatically),
+		Tags:              tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+	if regenerationPeriod != "" {
+		parameters.RegenerationPeriod = utils.String(regenerationPeriod)
+	}
+
+	if _, err := client.UpdateStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	if d.HasChange("regenerate_key") && d.Get("regenerate_key").(string) != "" {
+		activeKeyName := d.Get("storage_account_key").(string)
+		log.Printf("[DEBUG] Regenerating key %q for Managed Storage Account %q (Key Vault %q)..", activeKeyName, id.Name, id.KeyVaultBaseUrl)
+		parameters := keyvault.StorageAccountRegenerteKeyParameters{
+			KeyName: utils.String(activeKeyName),
+		}
+		if _, err := client.RegenerateStorageAccountKey(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+			return fmt.Errorf("regenerating key %q for Managed Storage Account %q (Key Vault %q): %+v", activeKeyName, id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	return keyVaultManagedStorageAccountRead(d, meta)
+}
+
+func keyVaultManagedStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Managed Storage Account %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Managed Storage Account %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Managed Storage Account %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("making Read request on Azure KeyVault Managed Storage Account %s: %+v", id.Name, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("storage_account_id", resp.ResourceID)
+	d.Set("storage_account_key", resp.ActiveKeyName)
+
+	regenerateKeyAutomatically := false
+	if resp.AutoRegenerateKey != nil {
+		regenerateKeyAutomatically = *resp.AutoRegenerateKey
+	}
+	d.Set("regenerate_key_automatically", regenerateKeyAutomatically)
+	d.Set("regeneration_period", resp.RegenerationPeriod)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func keyVaultManagedStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("deleting Managed Storage Account %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func keyVaultManagedStorageAccountCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("regenerate_key_automatically") || !d.NewValueKnown("regeneration_period") {
+		return nil
+	}
+
+	return validateKeyVaultManagedStorageAccountRegeneration(d.Get("regenerate_key_automatically").(bool), d.Get("regeneration_period").(string))
+}
+
+func validateKeyVaultManagedStorageAccountRegeneration(regenerateKeyAutomatically bool, regenerationPeriod string) error {
+	if regenerateKeyAutomatically && regenerationPeriod == "" {
+		return fmt.Errorf("`regeneration_period` must be specified when `regenerate_key_automatically` is `true`")
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type KeyVaultManagedStorageAccountResource struct{}
+
+func TestAccKeyVaultManagedStorageAccount_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccount_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_key_vault_managed_storage_account"),
+		},
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccount_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_account_key").HasValue("key2"),
+				check.That(data.ResourceName).Key("regenerate_key_automatically").HasValue("true"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccount_regenerateKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.regenerateKey(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("regenerate_key").HasValue("1"),
+			),
+		},
+		data.ImportStep("regenerate_key"),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccount_regenerationPeriodMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account", "test")
+	r := KeyVaultManagedStorageAccountResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.regenerationPeriodMissing(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`regeneration_period` must be specified when `regenerate_key_automatically` is `true`"),
+		},
+	})
+}
+
+func (KeyVaultManagedStorageAccountResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ManagedStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetStorageAccount(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key Vault Managed Storage Account %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r KeyVaultManagedStorageAccountResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_managed_storage_account" "test" {
+  name                = "acctestkvmsa%s"
+  key_vault_id        = azurestack_key_vault.test.id
+  storage_account_id  = azurestack_storage_account.test.id
+  storage_account_key = "key1"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedStorageAccountResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_managed_storage_account" "import" {
+  name                = azurestack_key_vault_managed_storage_account.test.name
+  key_vault_id        = azurestack_key_vault_managed_storage_account.test.key_vault_id
+  storage_account_id  = azurestack_key_vault_managed_storage_account.test.storage_account_id
+  storage_account_key = azurestack_key_vault_managed_storage_account.test.storage_account_key
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultManagedStorageAccountResource) regenerateKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_managed_storage_account" "test" {
+  name                = "acctestkvmsa%s"
+  key_vault_id        = azurestack_key_vault.test.id
+  storage_account_id  = azurestack_storage_account.test.id
+  storage_account_key = "key1"
+  regenerate_key      = "1"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedStorageAccountResource) regenerationPeriodMissing(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_managed_storage_account" "test" {
+  name                         = "acctestkvmsa%s"
+  key_vault_id                 = azurestack_key_vault.test.id
+  storage_account_id           = azurestack_storage_account.test.id
+  storage_account_key          = "key1"
+  regenerate_key_automatically = true
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedStorageAccountResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_managed_storage_account" "test" {
+  name                         = "acctestkvmsa%s"
+  key_vault_id                 = azurestack_key_vault.test.id
+  storage_account_id           = azurestack_storage_account.test.id
+  storage_account_key          = "key2"
+  regenerate_key_automatically = true
+  regeneration_period          = "P30D"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+// template requires that the Key Vault service has been granted the `Storage Account Key Operator Service Role`
+// on the Resource Group, since role assignments can't be managed using this provider
+func (KeyVaultManagedStorageAccountResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurestack_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  tenant_id           = data.azurestack_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurestack_client_config.current.tenant_id
+    object_id = data.azurestack_client_config.current.service_principal_object_id
+
+    secret_permissions = [
+      "Get",
+    ]
+
+    storage_permissions = [
+      "Delete",
+      "DeleteSAS",
+      "Get",
+      "GetSAS",
+      "List",
+      "ListSAS",
+      "RegenerateKey",
+      "Set",
+      "SetSAS",
+      "Update",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
+}
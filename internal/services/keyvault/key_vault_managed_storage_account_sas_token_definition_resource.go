@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// the services and resource types of an Account SAS, mapped to the values used within the SAS Definition parameters
+var (
+	keyVaultSasTokenDefinitionServices = map[string]string{
+		"blob":  "b",
+		"file":  "f",
+		"queue": "q",
+		"table": "t",
+	}
+
+	keyVaultSasTokenDefinitionResourceTypes = map[string]string{
+		"service":   "s",
+		"container": "c",
+		"object":    "o",
+	}
+)
+
+func keyVaultManagedStorageAccountSasTokenDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: keyVaultManagedStorageAccountSasTokenDefinitionCreate,
+		Read:   keyVaultManagedStorageAccountSasTokenDefinitionRead,
+		Update: keyVaultManagedStorageAccountSasTokenDefinitionUpdate,
+		Delete: keyVaultManagedStorageAccountSasTokenDefinitionDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedStorageAccountSasDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedStorageAccountName,
+			},
+
+			"managed_storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedStorageAccountID,
+			},
+
+			"validity_period": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.ISO8601Duration,
+			},
+
+			"services": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"blob",
+						"file",
+						"queue",
+						"table",
+					}, false),
+				},
+			},
+
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"service",
+						"container",
+						"object",
+					}, false),
+				},
+			},
+
+			"permissions": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[rwdlacup]+$`),
+					"`permissions` may only contain the characters `r`, `w`, `d`, `l`, `a`, `c`, `u` and `p`",
+				),
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"signed_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2016-05-31",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func keyVaultManagedStorageAccountSasTokenDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	storageAccountId, err := parse.ManagedStorageAccountID(d.Get("managed_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id, err := parse.NewManagedStorageAccountSasDefinitionID(storageAccountId.KeyVaultBaseUrl, storageAccountId.Name, name)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing SAS Definition %q (Managed Storage Account %q / Key Vault %q): %s", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurestack_key_vault_managed_storage_account_sas_token_definition", id.ID())
+	}
+
+	parameters := keyvault.SasDefinitionCreateParameters{
+		Parameters: expandKeyVaultSasTokenDefinitionParameters(d),
+		SasDefinitionAttributes: &keyvault.SasDefinitionAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.SetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("setting SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return keyVaultManagedStorageAccountSasTokenDefinitionRead(d, meta)
+}
+
+func keyVaultManagedStorageAccountSasTokenDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountSasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := keyvault.SasDefinitionUpdateParameters{
+		Parameters: expandKeyVaultSasTokenDefinitionParameters(d),
+		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.UpdateSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	return keyVaultManagedStorageAccountSasTokenDefinitionRead(d, meta)
+}
+
+func keyVaultManagedStorageAccountSasTokenDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountSasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for SAS Definition %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] SAS Definition %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SAS Definition %q (Managed Storage Account %q) was not found in Key Vault at URI %q - removing from state", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("making Read request on Azure KeyVault SAS Definition %s (Managed Storage Account %q): %+v", id.Name, id.StorageAccountName, err)
+	}
+
+	storageAccountId, err := parse.NewManagedStorageAccountID(id.KeyVaultBaseUrl, id.StorageAccountName)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_storage_account_id", storageAccountId.ID())
+
+	parameters := flattenKeyVaultSasTokenDefinitionParameters(resp.Parameters)
+	for _, key := range []string{"validity_period", "permissions", "https_only", "signed_version"} {
+		d.Set(key, parameters[key])
+	}
+	if err := d.Set("services", parameters["services"]); err != nil {
+		return fmt.Errorf("setting `services`: %+v", err)
+	}
+	if err := d.Set("resource_types", parameters["resource_types"]); err != nil {
+		return fmt.Errorf("setting `resource_types`: %+v", err)
+	}
+
+	secretId := ""
+	secretName := ""
+	if resp.SecretID != nil {
+		secretId = *resp.SecretID
+		if secret, err := parse.ParseOptionallyVersionedNestedItemID(secretId); err == nil {
+			secretName = secret.Name
+		}
+	}
+	d.Set("secret_id", secretId)
+	d.Set("secret_name", secretName)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func keyVaultManagedStorageAccountSasTokenDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedStorageAccountSasDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("deleting SAS Definition %q (Managed Storage Account %q / Key Vault %q): %+v", id.Name, id.StorageAccountName, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// expandKeyVaultSasTokenDefinitionParameters expands the Account SAS into the parameters of a SAS Definition
+func expandKeyVaultSasTokenDefinitionParameters(d *schema.ResourceData) map[string]*string {
+	services := ""
+	for _, v := range d.Get("services").(*schema.Set).List() {
+		services += keyVaultSasTokenDefinitionServices[v.(string)]
+	}
+
+	resourceTypes := ""
+	for _, v := range d.Get("resource_types").(*schema.Set).List() {
+		resourceTypes += keyVaultSasTokenDefinitionResourceTypes[v.(string)]
+	}
+
+	protocols := "https,http"
+	if d.Get("https_only").(bool) {
+		protocols = "https"
+	}
+
+	return map[string]*string{
+		"sasType":             utils.String("account"),
+		"signedServices":      utils.String(services),
+		"signedResourceTypes": utils.String(resourceTypes),
+		"signedPermissions":   utils.String(d.Get("permissions").(string)),
+		"signedProtocols":     utils.String(protocols),
+		"signedVersion":       utils.String(d.Get("signed_version").(string)),
+		"validityPeriod":      utils.String(d.Get("validity_period").(string)),
+	}
+}
+
+// flattenKeyVaultSasTokenDefinitionParameters flattens the parameters of a SAS Definition into the schema values
+func flattenKeyVaultSasTokenDefinitionParameters(input map[string]*string) map[string]interface{} {
+	parameter := func(key string) string {
+		if v, ok := input[key]; ok && v != nil {
+			return *v
+		}
+		return ""
+	}
+
+	services := make([]interface{}, 0)
+	for name, value := range keyVaultSasTokenDefinitionServices {
+		if strings.Contains(parameter("signedServices"), value) {
+			services = append(services, name)
+		}
+	}
+
+	resourceTypes := make([]interface{}, 0)
+	for name, value := range keyVaultSasTokenDefinitionResourceTypes {
+		if strings.Contains(parameter("signedResourceTypes"), value) {
+			resourceTypes = append(resourceTypes, name)
+		}
+	}
+
+	httpsOnly := true
+	for _, protocol := range strings.Split(parameter("signedProtocols"), ",") {
+		if strings.TrimSpace(protocol) == "http" {
+			httpsOnly = false
+		}
+	}
+
+	return map[string]interface{}{
+		"services":        services,
+		"resource_types":  resourceTypes,
+		"permissions":     parameter("signedPermissions"),
+		"https_only":      httpsOnly,
+		"signed_version":  parameter("signedVersion"),
+		"validity_period": parameter("validityPeriod"),
+	}
+}
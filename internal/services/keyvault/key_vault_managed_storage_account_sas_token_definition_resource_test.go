@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type KeyVaultManagedStorageAccountSasTokenDefinitionResource struct{}
+
+func TestAccKeyVaultManagedStorageAccountSasTokenDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account_sas_token_definition", "test")
+	r := KeyVaultManagedStorageAccountSasTokenDefinitionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret_id").IsSet(),
+				check.That(data.ResourceName).Key("secret_name").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccountSasTokenDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account_sas_token_definition", "test")
+	r := KeyVaultManagedStorageAccountSasTokenDefinitionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_key_vault_managed_storage_account_sas_token_definition"),
+		},
+	})
+}
+
+func TestAccKeyVaultManagedStorageAccountSasTokenDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_managed_storage_account_sas_token_definition", "test")
+	r := KeyVaultManagedStorageAccountSasTokenDefinitionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("services.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions").HasValue("rwl"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedStorageAccountSasTokenDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ManagedStorageAccountSasDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSasDefinition(ctx, id.KeyVaultBaseUrl, id.StorageAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key Vault Managed Storage Account SAS Definition %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (KeyVaultManagedStorageAccountSasTokenDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_managed_storage_account_sas_token_definition" "test" {
+  name                       = "acctestsas%s"
+  managed_storage_account_id = azurestack_key_vault_managed_storage_account.test.id
+  validity_period            = "P1D"
+  services                   = ["blob"]
+  resource_types             = ["container", "object"]
+  permissions                = "rl"
+}
+`, KeyVaultManagedStorageAccountResource{}.basic(data), data.RandomString)
+}
+
+func (r KeyVaultManagedStorageAccountSasTokenDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_managed_storage_account_sas_token_definition" "import" {
+  name                       = azurestack_key_vault_managed_storage_account_sas_token_definition.test.name
+  managed_storage_account_id = azurestack_key_vault_managed_storage_account_sas_token_definition.test.managed_storage_account_id
+  validity_period            = "P1D"
+  services                   = ["blob"]
+  resource_types             = ["container", "object"]
+  permissions                = "rl"
+}
+`, r.basic(data))
+}
+
+func (KeyVaultManagedStorageAccountSasTokenDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_managed_storage_account_sas_token_definition" "test" {
+  name                       = "acctestsas%s"
+  managed_storage_account_id = azurestack_key_vault_managed_storage_account.test.id
+  validity_period            = "PT12H"
+  services                   = ["blob", "queue"]
+  resource_types             = ["service", "container", "object"]
+  permissions                = "rwl"
+  https_only                 = false
+
+  tags = {
+    environment = "test"
+  }
+}
+`, KeyVaultManagedStorageAccountResource{}.basic(data), data.RandomString)
+}
@@ -222,6 +222,21 @@ func keyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurestack_key_vault", id.ID())
 	}
 
+	// check for the presence of a soft-deleted Key Vault with the same name, which needs to be recovered
+	recoverSoftDeletedKeyVault := false
+	softDeletedKeyVault, err := client.GetDeleted(ctx, id.Name, location)
+	if err != nil {
+		if !utils.ResponseWasNotFound(softDeletedKeyVault.Response) {
+			return fmt.Errorf("checking for the presence of an existing soft-deleted %s: %+v", id, err)
+		}
+	} else {
+		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults {
+			return fmt.Errorf("an existing soft-deleted Key Vault exists with the Name %q in the location %q, however recovering soft-deleted Key Vaults has been disabled using the `recover_soft_deleted_key_vaults` field within the `key_vault` block in the Provider `features` block - either recover or purge this Key Vault before it can be created", id.Name, location)
+		}
+		log.Printf("[DEBUG] Recovering soft-deleted %s", id)
+		recoverSoftDeletedKeyVault = true
+	}
+
 	tenantUUID := uuid.FromStringOrNil(d.Get("tenant_id").(string))
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
@@ -260,6 +275,12 @@ func keyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 		Tags: tags.Expand(t),
 	}
 
+	if recoverSoftDeletedKeyVault {
+		// soft delete can't be disabled once enabled, so it's omitted when recovering
+		parameters.Properties.CreateMode = keyvault.CreateModeRecover
+		parameters.Properties.EnableSoftDelete = nil
+	}
+
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 	virtualNetworkNames := make([]string, 0)
 	for _, v := range subnetIds {
@@ -567,6 +588,25 @@ func keyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// purging is only possible when soft delete is enabled and purge protection isn't
+	softDeleteEnabled := read.Properties.EnableSoftDelete != nil && *read.Properties.EnableSoftDelete
+	purgeProtectionEnabled := read.Properties.EnablePurgeProtection != nil && *read.Properties.EnablePurgeProtection
+	if meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy && softDeleteEnabled {
+		if purgeProtectionEnabled {
+			log.Printf("[DEBUG] Unable to purge %s since Purge Protection is enabled", *id)
+		} else {
+			log.Printf("[DEBUG] Purging soft-deleted %s..", *id)
+			future, err := client.PurgeDeleted(ctx, id.Name, *read.Location)
+			if err != nil {
+				return fmt.Errorf("purging %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the purge of %s: %+v", *id, err)
+			}
+		}
+	}
+
 	meta.(*clients.Client).KeyVault.Purge(*id)
 
 	return nil
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func keyVaultSecretBackupDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyVaultSecretBackupDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"backup": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func keyVaultSecretBackupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, keyVaultId, err)
+	}
+
+	resp, err := client.GetSecret(ctx, *keyVaultBaseUri, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Secret %q was not found in Key Vault at URI %q", name, *keyVaultBaseUri)
+		}
+
+		return err
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("retrieving Secret %q (Key Vault %q): `id` was nil", name, *keyVaultBaseUri)
+	}
+
+	id, err := parse.ParseNestedItemID(*resp.ID)
+	if err != nil {
+		return err
+	}
+
+	backup, err := client.BackupSecret(ctx, *keyVaultBaseUri, name)
+	if err != nil {
+		return fmt.Errorf("backing up Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+	}
+	if backup.Value == nil {
+		return fmt.Errorf("backing up Secret %q (Key Vault %q): `value` was nil", name, *keyVaultBaseUri)
+	}
+
+	d.SetId(id.ID())
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("backup", backup.Value)
+	d.Set("version", id.Version)
+
+	return nil
+}
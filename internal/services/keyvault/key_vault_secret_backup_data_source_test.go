@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+func TestAccKeyVaultSecretBackupDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_secret_backup", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			// the restore configuration backs up the Secret using the Data Source
+			Config: KeyVaultSecretRestoreResource{}.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("backup").Exists(),
+				check.That(data.ResourceName).Key("version").Exists(),
+			),
+		},
+	})
+}
@@ -40,6 +40,8 @@ func keyVaultSecret() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: keyVaultNestedItemRotationCustomizeDiff("value"),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -56,9 +58,11 @@ func keyVaultSecret() *schema.Resource {
 			},
 
 			"value": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "rotation"},
 			},
 
 			"content_type": {
@@ -73,16 +77,37 @@ func keyVaultSecret() *schema.Resource {
 			},
 
 			"expiration_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: keyVaultNestedItemExpirationDateDiffSuppress,
 			},
 
+			"rotation": keyVaultNestedItemRotationSchema(true),
+
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"version_created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled_previous_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -119,30 +144,50 @@ func keyVaultSecretCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	value := d.Get("value").(string)
+	if _, ok := d.GetOk("rotation"); ok {
+		if value, err = generateKeyVaultSecretValue(d.Get("rotation").([]interface{})); err != nil {
+			return fmt.Errorf("generating the value for Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+		}
+	}
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
+	secretAttributes, err := expandKeyVaultSecretAttributes(d, true)
+	if err != nil {
+		return err
+	}
+
 	parameters := keyvault.SecretSetParameters{
 		Value:            utils.String(value),
 		ContentType:      utils.String(contentType),
 		Tags:             tags.Expand(t),
-		SecretAttributes: &keyvault.SecretAttributes{},
+		SecretAttributes: secretAttributes,
 	}
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.SecretAttributes.NotBefore = &notBeforeUnixTime
-	}
+	if resp, err := client.SetSecret(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+		// a Conflict is returned when a soft-deleted Secret with the same name exists
+		if !utils.ResponseWasConflict(resp.Response) {
+			return err
+		}
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.SecretAttributes.Expires = &expirationUnixTime
-	}
+		description := fmt.Sprintf("Secret %q (Key Vault %q)", name, *keyVaultBaseUrl)
+		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedSecrets {
+			return softDeletedNestedItemExistsError(description, "recover_soft_deleted_secrets")
+		}
 
-	if _, err := client.SetSecret(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
-		return err
+		recoverer := deleteAndPurgeSecret{
+			client:      client,
+			keyVaultUri: *keyVaultBaseUrl,
+			name:        name,
+		}
+		if err := recoverSoftDeletedNestedItem(ctx, description, recoverer); err != nil {
+			return err
+		}
+
+		// setting the Secret again creates a new version using the configured values
+		if _, err := client.SetSecret(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+			return err
+		}
 	}
 
 	// "" indicates the latest version
@@ -195,25 +240,38 @@ func keyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	value := d.Get("value").(string)
-	contentType := d.Get("content_type").(string)
-	t := d.Get("tags").(map[string]interface{})
+	now := time.Now()
+	rotate, err := keyVaultNestedItemRotationIsDue(d, now)
+	if err != nil {
+		return err
+	}
+	disablePreviousVersions, err := keyVaultNestedItemPreviousVersionsDisableIsDue(d, now)
+	if err != nil {
+		return err
+	}
 
-	secretAttributes := &keyvault.SecretAttributes{}
+	// previous versions are disabled before rotating, so that the grace period applies to the current version
+	if disablePreviousVersions {
+		if err := disablePreviousKeyVaultSecretVersions(ctx, client, id.KeyVaultBaseUrl, id.Name, d.Get("version").(string)); err != nil {
+			return err
+		}
+	}
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		secretAttributes.NotBefore = &notBeforeUnixTime
+	value := d.Get("value").(string)
+	if rotate {
+		if value, err = generateKeyVaultSecretValue(d.Get("rotation").([]interface{})); err != nil {
+			return fmt.Errorf("generating a new value for Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
 	}
+	contentType := d.Get("content_type").(string)
+	t := d.Get("tags").(map[string]interface{})
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		secretAttributes.Expires = &expirationUnixTime
+	secretAttributes, err := expandKeyVaultSecretAttributes(d, rotate)
+	if err != nil {
+		return err
 	}
 
-	if d.HasChange("value") {
+	if rotate || d.HasChange("value") {
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
@@ -311,6 +369,7 @@ func keyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 
+	var created *date.UnixTime
 	if attributes := resp.Attributes; attributes != nil {
 		if v := attributes.NotBefore; v != nil {
 			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
@@ -319,11 +378,84 @@ func keyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
 		if v := attributes.Expires; v != nil {
 			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
 		}
+
+		created = attributes.Created
+	}
+
+	versionCreatedDate, nextRotationDate, err := flattenKeyVaultNestedItemRotationDates(d, created)
+	if err != nil {
+		return err
+	}
+	d.Set("version_created_date", versionCreatedDate)
+	d.Set("next_rotation_date", nextRotationDate)
+
+	enabledPreviousVersions := make([]interface{}, 0)
+	if rotation, _ := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{})); rotation != nil && rotation.DisablePreviousAfter != nil {
+		versions, err := listKeyVaultSecretVersions(ctx, client, id.KeyVaultBaseUrl, id.Name)
+		if err != nil {
+			return err
+		}
+		enabledPreviousVersions = enabledPreviousKeyVaultNestedItemVersions(versions, respID.Version)
+	}
+	if err := d.Set("enabled_previous_versions", enabledPreviousVersions); err != nil {
+		return fmt.Errorf("setting `enabled_previous_versions`: %+v", err)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+// expandKeyVaultSecretAttributes expands the attributes of a Secret, where `newVersion` calculates the expiration date
+// of the new version from `expire_after` within the `rotation` block when it's set
+func expandKeyVaultSecretAttributes(d *schema.ResourceData, newVersion bool) (*keyvault.SecretAttributes, error) {
+	secretAttributes := &keyvault.SecretAttributes{}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		secretAttributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		secretAttributes.Expires = &expirationUnixTime
+	}
+
+	if newVersion {
+		rotation, err := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		if rotation != nil && rotation.ExpireAfter != nil {
+			secretAttributes.Expires = rotation.expirationDate(time.Now())
+		}
+	}
+
+	return secretAttributes, nil
+}
+
+// disablePreviousKeyVaultSecretVersions disables each enabled version of the Secret other than the current version
+func disablePreviousKeyVaultSecretVersions(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl, name, currentVersion string) error {
+	versions, err := listKeyVaultSecretVersions(ctx, client, keyVaultBaseUrl, name)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range enabledPreviousKeyVaultNestedItemVersions(versions, currentVersion) {
+		log.Printf("[DEBUG] Disabling version %q of Secret %q (Key Vault %q)", version, name, keyVaultBaseUrl)
+		parameters := keyvault.SecretUpdateParameters{
+			SecretAttributes: &keyvault.SecretAttributes{
+				Enabled: utils.Bool(false),
+			},
+		}
+		if _, err := client.UpdateSecret(ctx, keyVaultBaseUrl, name, version.(string), parameters); err != nil {
+			return fmt.Errorf("disabling version %q of Secret %q (Key Vault %q): %+v", version, name, keyVaultBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
 func keyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
@@ -358,7 +490,11 @@ func keyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	shouldPurge := false
+	shouldPurge, err := shouldPurgeNestedItems(ctx, meta, *keyVaultId)
+	if err != nil {
+		return err
+	}
+
 	description := fmt.Sprintf("Secret %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
 	deleter := deleteAndPurgeSecret{
 		client:      client,
@@ -372,7 +508,10 @@ func keyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-var _ deleteAndPurgeNestedItem = deleteAndPurgeSecret{}
+var (
+	_ deleteAndPurgeNestedItem = deleteAndPurgeSecret{}
+	_ recoverNestedItem        = deleteAndPurgeSecret{}
+)
 
 type deleteAndPurgeSecret struct {
 	client      *keyvault.BaseClient
@@ -398,3 +537,13 @@ func (d deleteAndPurgeSecret) NestedItemHasBeenPurged(ctx context.Context) (auto
 	resp, err := d.client.GetDeletedSecret(ctx, d.keyVaultUri, d.name)
 	return resp.Response, err
 }
+
+func (d deleteAndPurgeSecret) RecoverNestedItem(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.RecoverDeletedSecret(ctx, d.keyVaultUri, d.name)
+	return resp.Response, err
+}
+
+func (d deleteAndPurgeSecret) NestedItemHasBeenRecovered(ctx context.Context) (autorest.Response, error) {
+	resp, err := d.client.GetSecret(ctx, d.keyVaultUri, d.name, "")
+	return resp.Response, err
+}
@@ -6,6 +6,7 @@ package keyvault_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
@@ -173,6 +174,33 @@ func TestAccKeyVaultSecret_withExternalAccessPolicy(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultSecret_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_secret", "test")
+	r := KeyVaultSecretResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.rotation(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").MatchesRegex(regexp.MustCompile("^[A-Z0-9]{24}$")),
+				check.That(data.ResourceName).Key("version_created_date").IsSet(),
+				check.That(data.ResourceName).Key("next_rotation_date").IsSet(),
+				check.That(data.ResourceName).Key("expiration_date").IsSet(),
+			),
+		},
+		data.ImportStep("rotation", "next_rotation_date"),
+		{
+			Config: r.rotationUpdated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_previous_versions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("rotation", "next_rotation_date"),
+	})
+}
+
 func (KeyVaultSecretResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.KeyVault.ManagementClient
 	keyVaultsClient := clients.KeyVault
@@ -350,6 +378,59 @@ resource "azurestack_key_vault_secret" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r KeyVaultSecretResource) rotation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_secret" "test" {
+  name         = "secret-%s"
+  key_vault_id = azurestack_key_vault.test.id
+
+  rotation {
+    rotate_after = "P30D"
+    expire_after = "P90D"
+    length       = 24
+    lower        = false
+    special      = false
+    min_numeric  = 4
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultSecretResource) rotationUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_secret" "test" {
+  name         = "secret-%s"
+  key_vault_id = azurestack_key_vault.test.id
+
+  rotation {
+    rotate_after           = "P30D"
+    expire_after           = "P90D"
+    disable_previous_after = "P7D"
+    length                 = 24
+    lower                  = false
+    special                = false
+    min_numeric            = 4
+  }
+
+  tags = {
+    Rick = "Morty"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
 func (KeyVaultSecretResource) withExternalAccessPolicy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -481,6 +562,7 @@ resource "azurestack_key_vault" "test" {
     secret_permissions = [
       "Get",
       "Delete",
+      "List",
       "Purge",
       "Recover",
       "Set",
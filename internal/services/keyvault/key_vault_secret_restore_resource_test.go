@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type KeyVaultSecretRestoreResource struct{}
+
+func TestAccKeyVaultSecretRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_secret_restore", "test")
+	r := KeyVaultSecretRestoreResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("versionless_id").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultSecretRestore_existingWithoutVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_key_vault_secret_restore", "test")
+	r := KeyVaultSecretRestoreResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.existingWithoutVersion(data),
+			ExpectError: regexp.MustCompile("an existing Secret .* was found - `version` must be specified"),
+		},
+	})
+}
+
+func (KeyVaultSecretRestoreResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r KeyVaultSecretRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+%s
+
+resource "azurestack_key_vault_secret" "source" {
+  name         = "secret-%s"
+  value        = "rick-and-morty"
+  key_vault_id = azurestack_key_vault.source.id
+}
+
+data "azurestack_key_vault_secret_backup" "test" {
+  name         = azurestack_key_vault_secret.source.name
+  key_vault_id = azurestack_key_vault.source.id
+}
+
+resource "azurestack_key_vault_secret_restore" "test" {
+  name         = azurestack_key_vault_secret.source.name
+  key_vault_id = azurestack_key_vault.target.id
+  backup       = data.azurestack_key_vault_secret_backup.test.backup
+  version      = data.azurestack_key_vault_secret_backup.test.version
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultSecretRestoreResource) existingWithoutVersion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_key_vault_secret_restore" "import" {
+  name         = azurestack_key_vault_secret_restore.test.name
+  key_vault_id = azurestack_key_vault_secret_restore.test.key_vault_id
+  backup       = azurestack_key_vault_secret_restore.test.backup
+}
+`, r.basic(data))
+}
+
+func (KeyVaultSecretRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_key_vault" "source" {
+  name                = "acctestkvs-%s"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  tenant_id           = data.azurestack_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurestack_client_config.current.tenant_id
+    object_id = data.azurestack_client_config.current.service_principal_object_id
+
+    secret_permissions = [
+      "Backup",
+      "Delete",
+      "Get",
+      "Purge",
+      "Set",
+    ]
+  }
+}
+
+resource "azurestack_key_vault" "target" {
+  name                = "acctestkvt-%s"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  tenant_id           = data.azurestack_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurestack_client_config.current.tenant_id
+    object_id = data.azurestack_client_config.current.service_principal_object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Restore",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
+}
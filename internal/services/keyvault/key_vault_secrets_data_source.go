@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func keyVaultSecretsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyVaultSecretsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"required_tags": tags.Schema(),
+
+			"include_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"not_before_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"expiration_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+
+						"versions": keyVaultNestedItemVersionsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func keyVaultSecretsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Secrets vault url from id %q: %+v", *keyVaultId, err)
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+	includeVersions := d.Get("include_versions").(bool)
+
+	names := make([]interface{}, 0)
+	secrets := make([]interface{}, 0)
+
+	resp, err := client.GetSecrets(ctx, *keyVaultBaseUri, nil)
+	if err != nil {
+		return fmt.Errorf("listing Secrets (Key Vault %q): %+v", *keyVaultBaseUri, err)
+	}
+
+	for resp.NotDone() {
+		for _, item := range resp.Values() {
+			if item.ID == nil {
+				continue
+			}
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(*item.ID)
+			if err != nil {
+				return err
+			}
+
+			if !keyVaultNestedItemMatchesFilter(*id, item.Tags, namePrefix, requiredTags) {
+				log.Printf("[DEBUG] Secret %q (Key Vault %q) skipped as it doesn't match the filter", id.Name, *keyVaultBaseUri)
+				continue
+			}
+
+			secret := flattenKeyVaultSecretItem(*id, item)
+			if includeVersions {
+				versions, err := listKeyVaultSecretVersions(ctx, client, *keyVaultBaseUri, id.Name)
+				if err != nil {
+					return err
+				}
+				secret["versions"] = versions
+			}
+
+			names = append(names, id.Name)
+			secrets = append(secrets, secret)
+		}
+
+		if err := resp.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Secrets (Key Vault %q): %+v", *keyVaultBaseUri, err)
+		}
+	}
+
+	d.SetId(keyVaultId.ID())
+	d.Set("key_vault_id", keyVaultId.ID())
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("setting `names`: %+v", err)
+	}
+	if err := d.Set("secrets", secrets); err != nil {
+		return fmt.Errorf("setting `secrets`: %+v", err)
+	}
+
+	return nil
+}
+
+func listKeyVaultSecretVersions(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUri string, name string) ([]interface{}, error) {
+	versions := make([]interface{}, 0)
+
+	resp, err := client.GetSecretVersions(ctx, keyVaultBaseUri, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing versions of Secret %q (Key Vault %q): %+v", name, keyVaultBaseUri, err)
+	}
+
+	for resp.NotDone() {
+		for _, item := range resp.Values() {
+			if item.ID == nil {
+				continue
+			}
+
+			id, err := parse.ParseNestedItemID(*item.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var attributes keyvault.SecretAttributes
+			if item.Attributes != nil {
+				attributes = *item.Attributes
+			}
+			versions = append(versions, flattenKeyVaultNestedItemVersion(*id, attributes.Enabled, attributes.NotBefore, attributes.Expires))
+		}
+
+		if err := resp.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing versions of Secret %q (Key Vault %q): %+v", name, keyVaultBaseUri, err)
+		}
+	}
+
+	return versions, nil
+}
+
+func flattenKeyVaultSecretItem(id parse.NestedItemId, item keyvault.SecretItem) map[string]interface{} {
+	var attributes keyvault.SecretAttributes
+	if item.Attributes != nil {
+		attributes = *item.Attributes
+	}
+	secret := flattenKeyVaultNestedItemVersion(id, attributes.Enabled, attributes.NotBefore, attributes.Expires)
+	delete(secret, "version")
+
+	contentType := ""
+	if item.ContentType != nil {
+		contentType = *item.ContentType
+	}
+
+	secret["name"] = id.Name
+	secret["content_type"] = contentType
+	secret["tags"] = tags.Flatten(item.Tags)
+	secret["versions"] = make([]interface{}, 0)
+
+	return secret
+}
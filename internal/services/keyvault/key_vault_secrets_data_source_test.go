@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type KeyVaultSecretsDataSource struct{}
+
+func TestAccKeyVaultSecretsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_secrets", "test")
+	r := KeyVaultSecretsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("secrets.#").HasValue("1"),
+				check.That(data.ResourceName).Key("secrets.0.content_type").HasValue("application/xml"),
+				check.That(data.ResourceName).Key("secrets.0.expiration_date").HasValue("2020-01-01T01:02:03Z"),
+				check.That(data.ResourceName).Key("secrets.0.tags.hello").HasValue("world"),
+				check.That(data.ResourceName).Key("secrets.0.versions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("secrets.0.versions.0.not_before_date").HasValue("2019-01-01T01:02:03Z"),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultSecretsDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_secrets", "test")
+	r := KeyVaultSecretsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("0"),
+				check.That(data.ResourceName).Key("secrets.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (KeyVaultSecretsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_key_vault_secrets" "test" {
+  key_vault_id     = azurestack_key_vault.test.id
+  name_prefix      = "secret-"
+  include_versions = true
+
+  required_tags = {
+    hello = "world"
+  }
+
+  depends_on = [azurestack_key_vault_secret.test]
+}
+`, KeyVaultSecretResource{}.complete(data))
+}
+
+func (KeyVaultSecretsDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_key_vault_secrets" "test" {
+  key_vault_id = azurestack_key_vault.test.id
+
+  required_tags = {
+    hello = "mars"
+  }
+
+  depends_on = [azurestack_key_vault_secret.test]
+}
+`, KeyVaultSecretResource{}.complete(data))
+}
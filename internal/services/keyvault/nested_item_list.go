@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+)
+
+// keyVaultNestedItemVersionsSchema returns the schema for the versions of a Nested Item (such as a Key or Secret)
+func keyVaultNestedItemVersionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+
+				"not_before_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"expiration_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// keyVaultNestedItemMatchesFilter returns whether the Nested Item matches the name prefix and has all of the required tags
+func keyVaultNestedItemMatchesFilter(id parse.NestedItemId, itemTags map[string]*string, namePrefix string, requiredTags map[string]interface{}) bool {
+	if namePrefix != "" && !strings.HasPrefix(strings.ToLower(id.Name), strings.ToLower(namePrefix)) {
+		return false
+	}
+
+	for requiredTagName, requiredTagVal := range requiredTags {
+		tagVal, ok := itemTags[requiredTagName]
+		if !ok || tagVal == nil || *tagVal != requiredTagVal.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flattenKeyVaultNestedItemVersion flattens the attributes of a single version of a Nested Item
+func flattenKeyVaultNestedItemVersion(id parse.NestedItemId, enabled *bool, notBefore *date.UnixTime, expires *date.UnixTime) map[string]interface{} {
+	isEnabled := false
+	if enabled != nil {
+		isEnabled = *enabled
+	}
+
+	notBeforeDate := ""
+	if notBefore != nil {
+		notBeforeDate = time.Time(*notBefore).Format(time.RFC3339)
+	}
+
+	expirationDate := ""
+	if expires != nil {
+		expirationDate = time.Time(*expires).Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		"version":         id.Version,
+		"id":              id.ID(),
+		"enabled":         isEnabled,
+		"not_before_date": notBeforeDate,
+		"expiration_date": expirationDate,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func TestKeyVaultNestedItemMatchesFilter(t *testing.T) {
+	id := parse.NestedItemId{
+		KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+		NestedItemType:  "secrets",
+		Name:            "Database-Password",
+	}
+	itemTags := map[string]*string{
+		"environment": utils.String("production"),
+		"owner":       utils.String("platform"),
+	}
+
+	testData := []struct {
+		Name         string
+		NamePrefix   string
+		RequiredTags map[string]interface{}
+		Expected     bool
+	}{
+		{
+			Name:     "no filter",
+			Expected: true,
+		},
+		{
+			Name:       "matching prefix",
+			NamePrefix: "database-",
+			Expected:   true,
+		},
+		{
+			Name:       "different prefix",
+			NamePrefix: "storage-",
+			Expected:   false,
+		},
+		{
+			Name: "matching tags",
+			RequiredTags: map[string]interface{}{
+				"environment": "production",
+				"owner":       "platform",
+			},
+			Expected: true,
+		},
+		{
+			Name: "different tag value",
+			RequiredTags: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: false,
+		},
+		{
+			Name: "missing tag",
+			RequiredTags: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Expected: false,
+		},
+		{
+			Name:       "matching prefix and different tag value",
+			NamePrefix: "Database",
+			RequiredTags: map[string]interface{}{
+				"owner": "security",
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := keyVaultNestedItemMatchesFilter(id, itemTags, v.NamePrefix, v.RequiredTags)
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+	"github.com/rickb777/date/period"
+)
+
+const (
+	keyVaultSecretValueLowerCharacters   = "abcdefghijklmnopqrstuvwxyz"
+	keyVaultSecretValueUpperCharacters   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	keyVaultSecretValueNumericCharacters = "0123456789"
+	keyVaultSecretValueSpecialCharacters = "!@#$%&*()-_=+[]{}<>:?"
+)
+
+// keyVaultNestedItemExpirationDateDiffSuppress suppresses the diff for `expiration_date` only when it's managed by
+// `expire_after` within the `rotation` block, since each new version then expires relative to when it was created
+func keyVaultNestedItemExpirationDateDiffSuppress(_, _, _ string, d *schema.ResourceData) bool {
+	v, ok := d.GetOk("rotation.0.expire_after")
+	return ok && v.(string) != ""
+}
+
+// keyVaultNestedItemRotationSchema returns the schema for the `rotation` block of a Key or Secret, where
+// `generatesValue` includes the character rules used to generate the value of a Secret
+func keyVaultNestedItemRotationSchema(generatesValue bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"rotate_after": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: utils.ISO8601Duration,
+		},
+
+		"expire_after": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ValidateFunc:  utils.ISO8601Duration,
+			ConflictsWith: []string{"expiration_date"},
+		},
+
+		"disable_previous_after": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.ISO8601Duration,
+		},
+	}
+
+	if generatesValue {
+		s["length"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      32,
+			ValidateFunc: validation.IntBetween(8, 1024),
+		}
+
+		for _, characters := range []string{"lower", "upper", "numeric", "special"} {
+			s[characters] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			}
+
+			s[fmt.Sprintf("min_%s", characters)] = &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			}
+		}
+
+		s["override_special"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+type keyVaultNestedItemRotation struct {
+	RotateAfter          period.Period
+	ExpireAfter          *period.Period
+	DisablePreviousAfter *period.Period
+}
+
+func expandKeyVaultNestedItemRotation(input []interface{}) (*keyVaultNestedItemRotation, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	rotateAfter, err := period.Parse(raw["rotate_after"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `rotate_after`: %+v", err)
+	}
+
+	rotation := keyVaultNestedItemRotation{
+		RotateAfter: rotateAfter,
+	}
+
+	if v := raw["expire_after"].(string); v != "" {
+		expireAfter, err := period.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `expire_after`: %+v", err)
+		}
+		rotation.ExpireAfter = &expireAfter
+	}
+
+	if v := raw["disable_previous_after"].(string); v != "" {
+		disablePreviousAfter, err := period.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `disable_previous_after`: %+v", err)
+		}
+		rotation.DisablePreviousAfter = &disablePreviousAfter
+	}
+
+	return &rotation, nil
+}
+
+// expirationDate returns the expiration date for a version created at the specified time, if `expire_after` is set
+func (r keyVaultNestedItemRotation) expirationDate(created time.Time) *date.UnixTime {
+	if r.ExpireAfter == nil {
+		return nil
+	}
+
+	expires, _ := r.ExpireAfter.AddTo(created)
+	expiresUnixTime := date.UnixTime(expires)
+	return &expiresUnixTime
+}
+
+// nextRotationDate returns the date at which a version created at the specified time should be rotated
+func (r keyVaultNestedItemRotation) nextRotationDate(created time.Time) time.Time {
+	next, _ := r.RotateAfter.AddTo(created)
+	return next
+}
+
+// keyVaultNestedItemRotationState is implemented by both `schema.ResourceData` and `schema.ResourceDiff` - the
+// computed attributes are read from the prior state, since these are unknown in the plan once a rotation is due
+type keyVaultNestedItemRotationState interface {
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+}
+
+// keyVaultNestedItemRotationIsDue returns whether the current version of the Key or Secret is older than `rotate_after`
+func keyVaultNestedItemRotationIsDue(d keyVaultNestedItemRotationState, now time.Time) (bool, error) {
+	rotation, err := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{}))
+	if err != nil || rotation == nil {
+		return false, err
+	}
+
+	created, ok, err := keyVaultNestedItemVersionCreatedDate(d)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return !now.Before(rotation.nextRotationDate(created)), nil
+}
+
+// keyVaultNestedItemPreviousVersionsDisableIsDue returns whether the current version of the Key or Secret is older
+// than `disable_previous_after` whilst previous versions are still enabled
+func keyVaultNestedItemPreviousVersionsDisableIsDue(d keyVaultNestedItemRotationState, now time.Time) (bool, error) {
+	rotation, err := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{}))
+	if err != nil || rotation == nil || rotation.DisablePreviousAfter == nil {
+		return false, err
+	}
+
+	if enabledPreviousVersions, _ := d.GetChange("enabled_previous_versions"); len(enabledPreviousVersions.([]interface{})) == 0 {
+		return false, nil
+	}
+
+	created, ok, err := keyVaultNestedItemVersionCreatedDate(d)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	disableAfter, _ := rotation.DisablePreviousAfter.AddTo(created)
+	return !now.Before(disableAfter), nil
+}
+
+func keyVaultNestedItemVersionCreatedDate(d keyVaultNestedItemRotationState) (time.Time, bool, error) {
+	raw, _ := d.GetChange("version_created_date")
+	v := raw.(string)
+	if v == "" {
+		return time.Time{}, false, nil
+	}
+
+	created, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("parsing `version_created_date` %q: %+v", v, err)
+	}
+
+	return created, true, nil
+}
+
+// keyVaultNestedItemRotationCustomizeDiff schedules a new version of the Key or Secret once the current version
+// is past its rotation age, marking the specified attributes (which change with each version) as computed
+func keyVaultNestedItemRotationCustomizeDiff(versionedAttributes ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		rotation, err := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		if d.HasChange("rotation") {
+			for _, attribute := range []string{"next_rotation_date", "enabled_previous_versions"} {
+				if err := d.SetNewComputed(attribute); err != nil {
+					return fmt.Errorf("setting `%s` to computed: %+v", attribute, err)
+				}
+			}
+		}
+
+		if rotation == nil {
+			return nil
+		}
+
+		now := time.Now()
+
+		rotate, err := keyVaultNestedItemRotationIsDue(d, now)
+		if err != nil {
+			return err
+		}
+		if rotate {
+			attributes := append([]string{"version", "version_created_date", "next_rotation_date"}, versionedAttributes...)
+			if rotation.DisablePreviousAfter != nil {
+				// the current version becomes a previous version
+				attributes = append(attributes, "enabled_previous_versions")
+			}
+
+			for _, attribute := range attributes {
+				if err := d.SetNewComputed(attribute); err != nil {
+					return fmt.Errorf("setting `%s` to computed: %+v", attribute, err)
+				}
+			}
+
+			return nil
+		}
+
+		disable, err := keyVaultNestedItemPreviousVersionsDisableIsDue(d, now)
+		if err != nil {
+			return err
+		}
+		if disable {
+			if err := d.SetNew("enabled_previous_versions", []interface{}{}); err != nil {
+				return fmt.Errorf("setting `enabled_previous_versions`: %+v", err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// flattenKeyVaultNestedItemRotationDates returns the `version_created_date` and `next_rotation_date` of the current version
+func flattenKeyVaultNestedItemRotationDates(d keyVaultNestedItemRotationState, created *date.UnixTime) (string, string, error) {
+	if created == nil {
+		return "", "", nil
+	}
+	createdDate := time.Time(*created)
+
+	rotation, err := expandKeyVaultNestedItemRotation(d.Get("rotation").([]interface{}))
+	if err != nil || rotation == nil {
+		return createdDate.Format(time.RFC3339), "", err
+	}
+
+	return createdDate.Format(time.RFC3339), rotation.nextRotationDate(createdDate).Format(time.RFC3339), nil
+}
+
+// enabledPreviousKeyVaultNestedItemVersions returns the enabled versions (as returned from listing the versions of a
+// Key or Secret) other than the current version
+func enabledPreviousKeyVaultNestedItemVersions(versions []interface{}, currentVersion string) []interface{} {
+	results := make([]interface{}, 0)
+	for _, v := range versions {
+		version := v.(map[string]interface{})
+		if version["version"].(string) == currentVersion || !version["enabled"].(bool) {
+			continue
+		}
+
+		results = append(results, version["version"].(string))
+	}
+
+	return results
+}
+
+// generateKeyVaultSecretValue generates a random value for a Secret using the character rules in the `rotation` block
+func generateKeyVaultSecretValue(input []interface{}) (string, error) {
+	if len(input) == 0 || input[0] == nil {
+		return "", fmt.Errorf("a `rotation` block is required to generate the value")
+	}
+	raw := input[0].(map[string]interface{})
+
+	length := raw["length"].(int)
+	special := keyVaultSecretValueSpecialCharacters
+	if v := raw["override_special"].(string); v != "" {
+		special = v
+	}
+
+	characterSets := []struct {
+		name       string
+		characters string
+	}{
+		{name: "lower", characters: keyVaultSecretValueLowerCharacters},
+		{name: "upper", characters: keyVaultSecretValueUpperCharacters},
+		{name: "numeric", characters: keyVaultSecretValueNumericCharacters},
+		{name: "special", characters: special},
+	}
+
+	result := make([]byte, 0, length)
+	allCharacters := ""
+	for _, set := range characterSets {
+		minimum := raw[fmt.Sprintf("min_%s", set.name)].(int)
+		if !raw[set.name].(bool) {
+			if minimum > 0 {
+				return "", fmt.Errorf("`min_%s` cannot be specified when `%s` is `false`", set.name, set.name)
+			}
+			continue
+		}
+		allCharacters += set.characters
+
+		for i := 0; i < minimum; i++ {
+			c, err := randomKeyVaultSecretCharacter(set.characters)
+			if err != nil {
+				return "", err
+			}
+			result = append(result, c)
+		}
+	}
+
+	if allCharacters == "" {
+		return "", fmt.Errorf("at least one of `lower`, `upper`, `numeric` or `special` must be `true`")
+	}
+	if len(result) > length {
+		return "", fmt.Errorf("the sum of the minimum number of characters (%d) cannot be greater than `length` (%d)", len(result), length)
+	}
+
+	for len(result) < length {
+		c, err := randomKeyVaultSecretCharacter(allCharacters)
+		if err != nil {
+			return "", err
+		}
+		result = append(result, c)
+	}
+
+	// shuffle so that the minimum characters aren't always at the start of the value
+	for i := len(result) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", fmt.Errorf("generating a random number: %+v", err)
+		}
+		result[i], result[j.Int64()] = result[j.Int64()], result[i]
+	}
+
+	return string(result), nil
+}
+
+func randomKeyVaultSecretCharacter(characters string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+	if err != nil {
+		return 0, fmt.Errorf("generating a random number: %+v", err)
+	}
+
+	return characters[i.Int64()], nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testKeyVaultNestedItemRotationState map[string]interface{}
+
+func (s testKeyVaultNestedItemRotationState) Get(key string) interface{} {
+	return s[key]
+}
+
+func (s testKeyVaultNestedItemRotationState) GetChange(key string) (interface{}, interface{}) {
+	return s[key], s[key]
+}
+
+func testKeyVaultNestedItemRotationBlock(rotateAfter, disablePreviousAfter string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"rotate_after":           rotateAfter,
+			"expire_after":           "",
+			"disable_previous_after": disablePreviousAfter,
+		},
+	}
+}
+
+func TestKeyVaultNestedItemRotationIsDue(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		Name               string
+		Rotation           []interface{}
+		VersionCreatedDate string
+		Expected           bool
+	}{
+		{
+			Name:               "no rotation",
+			Rotation:           []interface{}{},
+			VersionCreatedDate: "2020-01-01T00:00:00Z",
+			Expected:           false,
+		},
+		{
+			Name:               "not yet created",
+			Rotation:           testKeyVaultNestedItemRotationBlock("P30D", ""),
+			VersionCreatedDate: "",
+			Expected:           false,
+		},
+		{
+			Name:               "within the rotation period",
+			Rotation:           testKeyVaultNestedItemRotationBlock("P30D", ""),
+			VersionCreatedDate: "2022-05-15T12:00:00Z",
+			Expected:           false,
+		},
+		{
+			Name:               "exactly at the rotation period",
+			Rotation:           testKeyVaultNestedItemRotationBlock("P30D", ""),
+			VersionCreatedDate: "2022-05-02T12:00:00Z",
+			Expected:           true,
+		},
+		{
+			Name:               "past the rotation period",
+			Rotation:           testKeyVaultNestedItemRotationBlock("P1M", ""),
+			VersionCreatedDate: "2022-04-01T00:00:00Z",
+			Expected:           true,
+		},
+		{
+			Name:               "rotation period in hours",
+			Rotation:           testKeyVaultNestedItemRotationBlock("PT6H", ""),
+			VersionCreatedDate: "2022-06-01T07:00:00Z",
+			Expected:           false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := testKeyVaultNestedItemRotationState{
+			"rotation":             v.Rotation,
+			"version_created_date": v.VersionCreatedDate,
+		}
+		actual, err := keyVaultNestedItemRotationIsDue(state, now)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestKeyVaultNestedItemPreviousVersionsDisableIsDue(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		Name                    string
+		Rotation                []interface{}
+		EnabledPreviousVersions []interface{}
+		Expected                bool
+	}{
+		{
+			Name:                    "no grace period",
+			Rotation:                testKeyVaultNestedItemRotationBlock("P30D", ""),
+			EnabledPreviousVersions: []interface{}{"abc123"},
+			Expected:                false,
+		},
+		{
+			Name:                    "no previous versions enabled",
+			Rotation:                testKeyVaultNestedItemRotationBlock("P30D", "P1D"),
+			EnabledPreviousVersions: []interface{}{},
+			Expected:                false,
+		},
+		{
+			Name:                    "within the grace period",
+			Rotation:                testKeyVaultNestedItemRotationBlock("P30D", "P7D"),
+			EnabledPreviousVersions: []interface{}{"abc123"},
+			Expected:                false,
+		},
+		{
+			Name:                    "past the grace period",
+			Rotation:                testKeyVaultNestedItemRotationBlock("P30D", "P1D"),
+			EnabledPreviousVersions: []interface{}{"abc123"},
+			Expected:                true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := testKeyVaultNestedItemRotationState{
+			"rotation":                  v.Rotation,
+			"version_created_date":      "2022-05-30T12:00:00Z",
+			"enabled_previous_versions": v.EnabledPreviousVersions,
+		}
+		actual, err := keyVaultNestedItemPreviousVersionsDisableIsDue(state, now)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestKeyVaultNestedItemExpirationDateDiffSuppress(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Rotation []interface{}
+		Expected bool
+	}{
+		{
+			Name:     "no rotation",
+			Rotation: []interface{}{},
+			Expected: false,
+		},
+		{
+			Name:     "rotation without expire_after",
+			Rotation: testKeyVaultNestedItemRotationBlock("P30D", ""),
+			Expected: false,
+		},
+		{
+			Name: "rotation with expire_after",
+			Rotation: []interface{}{
+				map[string]interface{}{
+					"rotate_after": "P30D",
+					"expire_after": "P90D",
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := schema.TestResourceDataRaw(t, keyVaultKey().Schema, map[string]interface{}{
+			"rotation": v.Rotation,
+		})
+		actual := keyVaultNestedItemExpirationDateDiffSuppress("expiration_date", "2022-01-01T00:00:00Z", "", d)
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestEnabledPreviousKeyVaultNestedItemVersions(t *testing.T) {
+	versions := []interface{}{
+		map[string]interface{}{"version": "current", "enabled": true},
+		map[string]interface{}{"version": "previous", "enabled": true},
+		map[string]interface{}{"version": "disabled", "enabled": false},
+	}
+
+	actual := enabledPreviousKeyVaultNestedItemVersions(versions, "current")
+	if len(actual) != 1 || actual[0].(string) != "previous" {
+		t.Fatalf("expected only `previous` but got %+v", actual)
+	}
+}
+
+func TestGenerateKeyVaultSecretValue(t *testing.T) {
+	rotation := func(overrides map[string]interface{}) []interface{} {
+		raw := map[string]interface{}{
+			"length":           32,
+			"lower":            true,
+			"upper":            true,
+			"numeric":          true,
+			"special":          true,
+			"override_special": "",
+			"min_lower":        0,
+			"min_upper":        0,
+			"min_numeric":      0,
+			"min_special":      0,
+		}
+		for k, v := range overrides {
+			raw[k] = v
+		}
+		return []interface{}{raw}
+	}
+
+	testData := []struct {
+		Name       string
+		Overrides  map[string]interface{}
+		Characters string
+		Minimums   map[string]int
+		Error      bool
+	}{
+		{
+			Name:       "defaults",
+			Characters: keyVaultSecretValueLowerCharacters + keyVaultSecretValueUpperCharacters + keyVaultSecretValueNumericCharacters + keyVaultSecretValueSpecialCharacters,
+		},
+		{
+			Name:       "numeric only",
+			Overrides:  map[string]interface{}{"lower": false, "upper": false, "special": false},
+			Characters: keyVaultSecretValueNumericCharacters,
+		},
+		{
+			Name:       "override special",
+			Overrides:  map[string]interface{}{"lower": false, "upper": false, "numeric": false, "override_special": "-_"},
+			Characters: "-_",
+		},
+		{
+			Name:       "minimums",
+			Overrides:  map[string]interface{}{"length": 8, "min_upper": 4, "min_numeric": 4},
+			Characters: keyVaultSecretValueUpperCharacters + keyVaultSecretValueNumericCharacters,
+			Minimums: map[string]int{
+				keyVaultSecretValueUpperCharacters:   4,
+				keyVaultSecretValueNumericCharacters: 4,
+			},
+		},
+		{
+			Name:      "no characters",
+			Overrides: map[string]interface{}{"lower": false, "upper": false, "numeric": false, "special": false},
+			Error:     true,
+		},
+		{
+			Name:      "minimum of a disabled character set",
+			Overrides: map[string]interface{}{"special": false, "min_special": 1},
+			Error:     true,
+		},
+		{
+			Name:      "minimums longer than the length",
+			Overrides: map[string]interface{}{"length": 8, "min_lower": 5, "min_upper": 5},
+			Error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := generateKeyVaultSecretValue(rotation(v.Overrides))
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		expectedLength := 32
+		if length, ok := v.Overrides["length"]; ok {
+			expectedLength = length.(int)
+		}
+		if len(actual) != expectedLength {
+			t.Fatalf("expected a value of length %d but got %d", expectedLength, len(actual))
+		}
+
+		for _, c := range actual {
+			if !strings.ContainsRune(v.Characters, c) {
+				t.Fatalf("unexpected character %q in %q", c, actual)
+			}
+		}
+
+		for characters, minimum := range v.Minimums {
+			count := 0
+			for _, c := range actual {
+				if strings.ContainsRune(characters, c) {
+					count++
+				}
+			}
+			if count < minimum {
+				t.Fatalf("expected at least %d of %q but got %d in %q", minimum, characters, count, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+)
+
+var _ resourceid.Formatter = ContactsId{}
+
+// ContactsId identifies the Certificate Contacts of a Key Vault, of which there is only a single set per Key Vault
+type ContactsId struct {
+	KeyVaultBaseUrl string
+}
+
+func NewContactsID(keyVaultBaseUrl string) (*ContactsId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", keyVaultBaseUrl, err)
+	}
+	if hostParts := strings.Split(keyVaultUrl.Host, ":"); len(hostParts) > 1 {
+		keyVaultUrl.Host = hostParts[0]
+	}
+
+	return &ContactsId{
+		KeyVaultBaseUrl: keyVaultUrl.String(),
+	}, nil
+}
+
+func (id ContactsId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/certificates/contacts
+	return fmt.Sprintf("%s/certificates/contacts", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"))
+}
+
+// ContactsID parses a Key Vault Certificate Contacts ID into a ContactsId object
+func ContactsID(input string) (*ContactsId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Certificate Contacts Id: %s", err)
+	}
+
+	if path := strings.Trim(idURL.Path, "/"); path != "certificates/contacts" {
+		return nil, fmt.Errorf("KeyVault Certificate Contacts should be in the format `certificates/contacts`, got %q", path)
+	}
+
+	return &ContactsId{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+	}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestContactsID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    ContactsId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/contacts/example",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/certificates/contacts",
+			Expected: ContactsId{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := ContactsID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but got none", tc.Input)
+		}
+
+		if id.KeyVaultBaseUrl != tc.Expected.KeyVaultBaseUrl {
+			t.Fatalf("Expected 'KeyVaultBaseUrl' to be '%s', got '%s' for ID '%s'", tc.Expected.KeyVaultBaseUrl, id.KeyVaultBaseUrl, tc.Input)
+		}
+
+		if id.ID() != tc.Input {
+			t.Fatalf("Expected 'ID()' to be '%s', got '%s'", tc.Input, id.ID())
+		}
+	}
+}
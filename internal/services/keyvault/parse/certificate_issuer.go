@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+)
+
+var _ resourceid.Formatter = IssuerId{}
+
+type IssuerId struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+func NewIssuerID(keyVaultBaseUrl, name string) (*IssuerId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", keyVaultBaseUrl, err)
+	}
+	if hostParts := strings.Split(keyVaultUrl.Host, ":"); len(hostParts) > 1 {
+		keyVaultUrl.Host = hostParts[0]
+	}
+
+	return &IssuerId{
+		KeyVaultBaseUrl: keyVaultUrl.String(),
+		Name:            name,
+	}, nil
+}
+
+func (id IssuerId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/certificates/issuers/example
+	return fmt.Sprintf("%s/certificates/issuers/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.Name)
+}
+
+// IssuerID parses a Key Vault Certificate Issuer ID into an IssuerId object
+func IssuerID(input string) (*IssuerId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Certificate Issuer Id: %s", err)
+	}
+
+	path := strings.Trim(idURL.Path, "/")
+	components := strings.Split(path, "/")
+	if len(components) != 3 || components[0] != "certificates" || components[1] != "issuers" || components[2] == "" {
+		return nil, fmt.Errorf("KeyVault Certificate Issuer should be in the format `certificates/issuers/{name}`, got %q", path)
+	}
+
+	return &IssuerId{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Name:            components[2],
+	}, nil
+}
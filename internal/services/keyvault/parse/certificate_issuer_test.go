@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestIssuerID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    IssuerId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/example",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/certificates/issuers",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/issuers/example",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/certificates/issuers/example",
+			Expected: IssuerId{
+				KeyVaultBaseUrl: "https://my-keyvault.vault.azure.net/",
+				Name:            "example",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := IssuerID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but got none", tc.Input)
+		}
+
+		if id.KeyVaultBaseUrl != tc.Expected.KeyVaultBaseUrl {
+			t.Fatalf("Expected 'KeyVaultBaseUrl' to be '%s', got '%s' for ID '%s'", tc.Expected.KeyVaultBaseUrl, id.KeyVaultBaseUrl, tc.Input)
+		}
+
+		if id.Name != tc.Expected.Name {
+			t.Fatalf("Expected 'Name' to be '%s', got '%s' for ID '%s'", tc.Expected.Name, id.Name, tc.Input)
+		}
+
+		if id.ID() != tc.Input {
+			t.Fatalf("Expected 'ID()' to be '%s', got '%s'", tc.Input, id.ID())
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedStorageAccountId{}
+
+type ManagedStorageAccountId struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+func NewManagedStorageAccountID(keyVaultBaseUrl, name string) (*ManagedStorageAccountId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", keyVaultBaseUrl, err)
+	}
+	if hostParts := strings.Split(keyVaultUrl.Host, ":"); len(hostParts) > 1 {
+		keyVaultUrl.Host = hostParts[0]
+	}
+
+	return &ManagedStorageAccountId{
+		KeyVaultBaseUrl: keyVaultUrl.String(),
+		Name:            name,
+	}, nil
+}
+
+func (id ManagedStorageAccountId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/storage/example
+	return fmt.Sprintf("%s/storage/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.Name)
+}
+
+// ManagedStorageAccountID parses a Key Vault Managed Storage Account ID into a ManagedStorageAccountId object
+func ManagedStorageAccountID(input string) (*ManagedStorageAccountId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Managed Storage Account Id: %s", err)
+	}
+
+	path := strings.Trim(idURL.Path, "/")
+	components := strings.Split(path, "/")
+	if len(components) != 2 || components[0] != "storage" || components[1] == "" {
+		return nil, fmt.Errorf("KeyVault Managed Storage Account should be in the format `storage/{name}`, got %q", path)
+	}
+
+	return &ManagedStorageAccountId{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Name:            components[1],
+	}, nil
+}
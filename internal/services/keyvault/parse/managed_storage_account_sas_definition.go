@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedStorageAccountSasDefinitionId{}
+
+type ManagedStorageAccountSasDefinitionId struct {
+	KeyVaultBaseUrl    string
+	StorageAccountName string
+	Name               string
+}
+
+func NewManagedStorageAccountSasDefinitionID(keyVaultBaseUrl, storageAccountName, name string) (*ManagedStorageAccountSasDefinitionId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing %q: %+v", keyVaultBaseUrl, err)
+	}
+	if hostParts := strings.Split(keyVaultUrl.Host, ":"); len(hostParts) > 1 {
+		keyVaultUrl.Host = hostParts[0]
+	}
+
+	return &ManagedStorageAccountSasDefinitionId{
+		KeyVaultBaseUrl:    keyVaultUrl.String(),
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}, nil
+}
+
+func (id ManagedStorageAccountSasDefinitionId) ID() string {
+	// example: https://example-keyvault.vault.azure.net/storage/example/sas/example
+	return fmt.Sprintf("%s/storage/%s/sas/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.StorageAccountName, id.Name)
+}
+
+// ManagedStorageAccountSasDefinitionID parses a Key Vault Managed Storage Account SAS Definition ID into a
+// ManagedStorageAccountSasDefinitionId object
+func ManagedStorageAccountSasDefinitionID(input string) (*ManagedStorageAccountSasDefinitionId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Managed Storage Account SAS Definition Id: %s", err)
+	}
+
+	path := strings.Trim(idURL.Path, "/")
+	components := strings.Split(path, "/")
+	if len(components) != 4 || components[0] != "storage" || components[1] == "" || components[2] != "sas" || components[3] == "" {
+		return nil, fmt.Errorf("KeyVault Managed Storage Account SAS Definition should be in the format `storage/{storageAccountName}/sas/{name}`, got %q", path)
+	}
+
+	return &ManagedStorageAccountSasDefinitionId{
+		KeyVaultBaseUrl:    fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		StorageAccountName: components[1],
+		Name:               components[3],
+	}, nil
+}
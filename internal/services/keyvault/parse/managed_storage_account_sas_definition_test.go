@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestManagedStorageAccountSasDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    ManagedStorageAccountSasDefinitionId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account/sas",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/storage/account/keys/example",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://my-keyvault.vault.azure.net/secrets/account/sas/example",
+			ExpectError: true,
+		},
+		{
+			Input: "https://my-keyvault.vault.azure.net/storage/account/sas/example",
+			Expected: ManagedStorageAccountSasDefinitionId{
+				KeyVaultBaseUrl:    "https://my-keyvault.vault.azure.net/",
+				StorageAccountName: "account",
+				Name:               "example",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := ManagedStorageAccountSasDefinitionID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID '%s': %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID '%s' but got none", tc.Input)
+		}
+
+		if id.KeyVaultBaseUrl != tc.Expected.KeyVaultBaseUrl {
+			t.Fatalf("Expected 'KeyVaultBaseUrl' to be '%s', got '%s' for ID '%s'", tc.Expected.KeyVaultBaseUrl, id.KeyVaultBaseUrl, tc.Input)
+		}
+
+		if id.StorageAccountName != tc.Expected.StorageAccountName {
+			t.Fatalf("Expected 'StorageAccountName' to be '%s', got '%s' for ID '%s'", tc.Expected.StorageAccountName, id.StorageAccountName, tc.Input)
+		}
+
+		if id.Name != tc.Expected.Name {
+			t.Fatalf("Expected 'Name' to be '%s', got '%s' for ID '%s'", tc.Expected.Name, id.Name, tc.Input)
+		}
+
+		if id.ID() != tc.Input {
+			t.Fatalf("Expected 'ID()' to be '%s', got '%s'", tc.Input, id.ID())
+		}
+	}
+}
@@ -24,19 +24,37 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurestack_key_vault_access_policy": keyVaultAccessPolicyDataSource(),
-		"azurestack_key_vault_key":           keyVaultKeyDataSource(),
-		"azurestack_key_vault_secret":        keyVaultSecretDataSource(),
-		"azurestack_key_vault":               keyVaultDataSource(),
+		"azurestack_key_vault_access_policy":     keyVaultAccessPolicyDataSource(),
+		"azurestack_key_vault_certificate":       keyVaultCertificateDataSource(),
+		"azurestack_key_vault_key":               keyVaultKeyDataSource(),
+		"azurestack_key_vault_key_backup":        keyVaultKeyBackupDataSource(),
+		"azurestack_key_vault_key_decryption":    keyVaultKeyDecryptionDataSource(),
+		"azurestack_key_vault_key_encryption":    keyVaultKeyEncryptionDataSource(),
+		"azurestack_key_vault_key_signature":     keyVaultKeySignatureDataSource(),
+		"azurestack_key_vault_key_unwrapped_key": keyVaultKeyUnwrappedKeyDataSource(),
+		"azurestack_key_vault_key_verification":  keyVaultKeyVerificationDataSource(),
+		"azurestack_key_vault_key_wrapped_key":   keyVaultKeyWrappedKeyDataSource(),
+		"azurestack_key_vault_keys":              keyVaultKeysDataSource(),
+		"azurestack_key_vault_secret":            keyVaultSecretDataSource(),
+		"azurestack_key_vault_secret_backup":     keyVaultSecretBackupDataSource(),
+		"azurestack_key_vault_secrets":           keyVaultSecretsDataSource(),
+		"azurestack_key_vault":                   keyVaultDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurestack_key_vault_access_policy": keyVaultAccessPolicy(),
-		"azurestack_key_vault_key":           keyVaultKey(),
-		"azurestack_key_vault_secret":        keyVaultSecret(),
-		"azurestack_key_vault":               keyVault(),
+		"azurestack_key_vault_access_policy":                                keyVaultAccessPolicy(),
+		"azurestack_key_vault_certificate":                                  keyVaultCertificate(),
+		"azurestack_key_vault_certificate_contacts":                         keyVaultCertificateContacts(),
+		"azurestack_key_vault_certificate_issuer":                           keyVaultCertificateIssuer(),
+		"azurestack_key_vault_key":                                          keyVaultKey(),
+		"azurestack_key_vault_key_restore":                                  keyVaultKeyRestore(),
+		"azurestack_key_vault_managed_storage_account":                      keyVaultManagedStorageAccount(),
+		"azurestack_key_vault_managed_storage_account_sas_token_definition": keyVaultManagedStorageAccountSasTokenDefinition(),
+		"azurestack_key_vault_secret":                                       keyVaultSecret(),
+		"azurestack_key_vault_secret_restore":                               keyVaultSecretRestore(),
+		"azurestack_key_vault":                                              keyVault(),
 	}
 }
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Base64 validates that the value is base64 encoded, using either the standard or the URL encoding
+// with or without padding, since Key Vault uses the URL encoding whilst Terraform uses the standard encoding
+func Base64(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	value := strings.TrimRight(strings.TrimSpace(v), "=")
+	value = strings.NewReplacer("+", "-", "/", "_").Replace(value)
+	if _, err := base64.RawURLEncoding.DecodeString(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64 encoded: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestBase64(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			// standard encoding with padding
+			Input:       "aGVsbG8gd29ybGQ=",
+			ExpectError: false,
+		},
+		{
+			// url encoding without padding
+			Input:       "aGVsbG8gd29ybGQ",
+			ExpectError: false,
+		},
+		{
+			// standard encoding using the `+` and `/` characters
+			Input:       "+/+/",
+			ExpectError: false,
+		},
+		{
+			// url encoding using the `-` and `_` characters
+			Input:       "-_-_",
+			ExpectError: false,
+		},
+		{
+			Input:       "hello world!",
+			ExpectError: true,
+		},
+		{
+			Input:       "a",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		_, errors := Base64(tc.Input, "value")
+		if (len(errors) > 0) != tc.ExpectError {
+			t.Fatalf("expected an error to be %t for %q but got %+v", tc.ExpectError, tc.Input, errors)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+)
+
+// ManagedStorageAccountName validates the name of a Managed Storage Account or SAS Definition within a Key Vault
+func ManagedStorageAccountName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z]+$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters", k))
+	}
+
+	return warnings, errors
+}
+
+func ManagedStorageAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := parse.ManagedStorageAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("can not parse %q as a Key Vault Managed Storage Account id: %v", k, err))
+	}
+
+	return warnings, errors
+}
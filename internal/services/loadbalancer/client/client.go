@@ -9,24 +9,34 @@ import (
 )
 
 type Client struct {
+	InboundNatRulesClient                 *network.InboundNatRulesClient
 	LoadBalancersClient                   *network.LoadBalancersClient
 	LoadBalancerBackendAddressPoolsClient *network.LoadBalancerBackendAddressPoolsClient
+	LoadBalancerProbesClient              *network.LoadBalancerProbesClient
 	LoadBalancingRulesClient              *network.LoadBalancerLoadBalancingRulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	inboundNatRulesClient := network.NewInboundNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&inboundNatRulesClient.Client, o.ResourceManagerAuthorizer)
+
 	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&loadBalancersClient.Client, o.ResourceManagerAuthorizer)
 
 	loadBalancerBackendAddressPoolsClient := network.NewLoadBalancerBackendAddressPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&loadBalancerBackendAddressPoolsClient.Client, o.ResourceManagerAuthorizer)
 
+	loadBalancerProbesClient := network.NewLoadBalancerProbesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&loadBalancerProbesClient.Client, o.ResourceManagerAuthorizer)
+
 	loadBalancingRulesClient := network.NewLoadBalancerLoadBalancingRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&loadBalancingRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		InboundNatRulesClient:                 &inboundNatRulesClient,
 		LoadBalancersClient:                   &loadBalancersClient,
 		LoadBalancerBackendAddressPoolsClient: &loadBalancerBackendAddressPoolsClient,
+		LoadBalancerProbesClient:              &loadBalancerProbesClient,
 		LoadBalancingRulesClient:              &loadBalancingRulesClient,
 	}
 }
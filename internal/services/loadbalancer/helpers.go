@@ -5,9 +5,13 @@ package loadbalancer
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	computeParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 )
@@ -28,18 +32,18 @@ func FindLoadBalancerBackEndAddressPoolByName(lb *network.LoadBalancer, name str
 	return nil, -1, false
 }
 
-func FindLoadBalancerFrontEndIpConfigurationByName(lb *network.LoadBalancer, name string) (*network.FrontendIPConfiguration, bool) {
+func FindLoadBalancerFrontEndIpConfigurationByName(lb *network.LoadBalancer, name string) (*network.FrontendIPConfiguration, int, bool) {
 	if lb == nil || lb.LoadBalancerPropertiesFormat == nil || lb.LoadBalancerPropertiesFormat.FrontendIPConfigurations == nil {
-		return nil, false
+		return nil, -1, false
 	}
 
-	for _, feip := range *lb.LoadBalancerPropertiesFormat.FrontendIPConfigurations {
+	for i, feip := range *lb.LoadBalancerPropertiesFormat.FrontendIPConfigurations {
 		if feip.Name != nil && *feip.Name == name {
-			return &feip, true
+			return &feip, i, true
 		}
 	}
 
-	return nil, false
+	return nil, -1, false
 }
 
 func FindLoadBalancerRuleByName(lb *network.LoadBalancer, name string) (*network.LoadBalancingRule, int, bool) {
@@ -112,3 +116,50 @@ func loadBalancerSubResourceImporter(parser func(input string) (*parse.LoadBalan
 		return []*pluginsdk.ResourceData{d}, nil
 	})
 }
+
+// listLoadBalancerNatPoolInboundNatRules returns the Inbound NAT Rules which Azure creates for each Scale Set
+// instance attached to the specified NAT Pool, these are named `{natPoolName}.{index}`
+func listLoadBalancerNatPoolInboundNatRules(ctx context.Context, client *network.InboundNatRulesClient, id parse.LoadBalancerInboundNatPoolId) ([]network.InboundNatRule, error) {
+	rules := make([]network.InboundNatRule, 0)
+
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.LoadBalancerName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Inbound NAT Rules for %s: %+v", id, err)
+	}
+
+	prefix := id.InboundNatPoolName + "."
+	for iterator.NotDone() {
+		rule := iterator.Value()
+		if rule.Name != nil && strings.HasPrefix(*rule.Name, prefix) {
+			rules = append(rules, rule)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Inbound NAT Rules for %s: %+v", id, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// parseScaleSetVMIPConfigurationID parses the ID of an IP Configuration within a Network Interface of a Scale Set
+// instance, returning the ID of the Scale Set and the ID of the Network Interface
+func parseScaleSetVMIPConfigurationID(input string) (*computeParse.VirtualMachineScaleSetId, string, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing Backend IP Configuration ID %q: %+v", input, err)
+	}
+
+	scaleSetName, ok := id.Path["virtualMachineScaleSets"]
+	if !ok {
+		return nil, "", fmt.Errorf("Backend IP Configuration ID %q doesn't belong to a Virtual Machine Scale Set", input)
+	}
+
+	index := strings.Index(strings.ToLower(input), "/ipconfigurations/")
+	if index == -1 {
+		return nil, "", fmt.Errorf("Backend IP Configuration ID %q doesn't contain an IP Configuration", input)
+	}
+
+	scaleSetId := computeParse.NewVirtualMachineScaleSetID(id.SubscriptionID, id.ResourceGroup, scaleSetName)
+	return &scaleSetId, input[:index], nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import "testing"
+
+func TestParseScaleSetVMIPConfigurationID(t *testing.T) {
+	testData := []struct {
+		Input            string
+		ScaleSetId       string
+		NetworkInterface string
+		Error            bool
+	}{
+		{
+			Input:            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1/virtualMachines/3/networkInterfaces/nic1/ipConfigurations/internal",
+			ScaleSetId:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1",
+			NetworkInterface: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1/virtualMachines/3/networkInterfaces/nic1",
+		},
+		{
+			// a regular Network Interface
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/internal",
+			Error: true,
+		},
+		{
+			// missing the IP Configuration
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1/virtualMachines/3/networkInterfaces/nic1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		scaleSetId, networkInterfaceId, err := parseScaleSetVMIPConfigurationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual := scaleSetId.ID(); actual != v.ScaleSetId {
+			t.Fatalf("expected Scale Set ID %q but got %q", v.ScaleSetId, actual)
+		}
+		if networkInterfaceId != v.NetworkInterface {
+			t.Fatalf("expected Network Interface ID %q but got %q", v.NetworkInterface, networkInterfaceId)
+		}
+	}
+}
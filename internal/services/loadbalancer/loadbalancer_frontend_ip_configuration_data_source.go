@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func loadBalancerFrontendIpConfigurationDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: loadBalancerFrontendIpConfigurationDataSourceRead,
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"subnet_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address_allocation": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_address_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"load_balancer_rules": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"inbound_nat_rules": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+		},
+	}
+}
+
+func loadBalancerFrontendIpConfigurationDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewLoadBalancerFrontendIpConfigurationID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+
+	loadBalancer, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			return fmt.Errorf("Error: %s was not found", *loadBalancerId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+	}
+
+	config, _, exists := FindLoadBalancerFrontEndIpConfigurationByName(&loadBalancer, id.FrontendIPConfigurationName)
+	if !exists {
+		return fmt.Errorf("Error: %s was not found", id)
+	}
+
+	d.SetId(id.ID())
+
+	if props := config.FrontendIPConfigurationPropertiesFormat; props != nil {
+		return setLoadBalancerFrontendIpConfigurationProperties(d, props)
+	}
+
+	return nil
+}
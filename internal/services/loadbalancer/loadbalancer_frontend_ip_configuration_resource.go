@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/state"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func loadBalancerFrontendIpConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: loadBalancerFrontendIpConfigurationCreateUpdate,
+		Read:   loadBalancerFrontendIpConfigurationRead,
+		Update: loadBalancerFrontendIpConfigurationCreateUpdate,
+		Delete: loadBalancerFrontendIpConfigurationDelete,
+
+		Importer: loadBalancerSubResourceImporter(func(input string) (*parse.LoadBalancerId, error) {
+			id, err := parse.LoadBalancerFrontendIpConfigurationID(input)
+			if err != nil {
+				return nil, err
+			}
+
+			lbId := parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName)
+			return &lbId, nil
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: resourceid.ValidateResourceID,
+				ExactlyOneOf: []string{"subnet_id", "public_ip_address_id"},
+			},
+
+			"private_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+				RequiredWith: []string{"subnet_id"},
+			},
+
+			"private_ip_address_allocation": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dynamic),
+					string(network.Static),
+				}, true),
+				StateFunc:        state.IgnoreCase,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"public_ip_address_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: resourceid.ValidateResourceID,
+				ExactlyOneOf: []string{"subnet_id", "public_ip_address_id"},
+			},
+
+			"load_balancer_rules": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"inbound_nat_rules": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+		},
+	}
+}
+
+func loadBalancerFrontendIpConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+	loadBalancerIDRaw := loadBalancerId.ID()
+	id := parse.NewLoadBalancerFrontendIpConfigurationID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+	locks.ByID(loadBalancerIDRaw)
+	defer locks.UnlockByID(loadBalancerIDRaw)
+
+	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			d.SetId("")
+			log.Printf("[INFO] Load Balancer %q not found. Removing Frontend IP Configuration %q from state", id.LoadBalancerName, id.FrontendIPConfigurationName)
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+	}
+	if loadBalancer.LoadBalancerPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *loadBalancerId)
+	}
+
+	frontendIPConfigurations := make([]network.FrontendIPConfiguration, 0)
+	if loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations != nil {
+		frontendIPConfigurations = *loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations
+	}
+
+	existing, existingIndex, exists := FindLoadBalancerFrontEndIpConfigurationByName(&loadBalancer, id.FrontendIPConfigurationName)
+	if exists {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurestack_lb_frontend_ip_configuration", *existing.ID)
+		}
+
+		// this Frontend IP Configuration is being updated/reapplied remove old copy from the slice
+		frontendIPConfigurations = append(frontendIPConfigurations[:existingIndex], frontendIPConfigurations[existingIndex+1:]...)
+	}
+
+	frontendIPConfigurations = append(frontendIPConfigurations, expandLoadBalancerFrontendIpConfiguration(d))
+	loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations = &frontendIPConfigurations
+
+	future, err := client.CreateOrUpdate(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, loadBalancer)
+	if err != nil {
+		return fmt.Errorf("updating %s for %s: %+v", *loadBalancerId, id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for %s: %+v", *loadBalancerId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return loadBalancerFrontendIpConfigurationRead(d, meta)
+}
+
+func loadBalancerFrontendIpConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LoadBalancerFrontendIpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	loadBalancer, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			d.SetId("")
+			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
+			return nil
+		}
+		return fmt.Errorf("retrieving Load Balancer for %s: %+v", *id, err)
+	}
+
+	config, _, exists := FindLoadBalancerFrontEndIpConfigurationByName(&loadBalancer, id.FrontendIPConfigurationName)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] %s was not found - removing from state", *id)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+	d.Set("loadbalancer_id", parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName).ID())
+
+	if props := config.FrontendIPConfigurationPropertiesFormat; props != nil {
+		return setLoadBalancerFrontendIpConfigurationProperties(d, props)
+	}
+
+	return nil
+}
+
+func loadBalancerFrontendIpConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LoadBalancerFrontendIpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	loadBalancerId := parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName)
+	loadBalancerID := loadBalancerId.ID()
+	locks.ByID(loadBalancerID)
+	defer locks.UnlockByID(loadBalancerID)
+
+	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", loadBalancerId, err)
+	}
+
+	_, index, exists := FindLoadBalancerFrontEndIpConfigurationByName(&loadBalancer, id.FrontendIPConfigurationName)
+	if !exists {
+		return nil
+	}
+
+	frontendIPConfigurations := *loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations
+	frontendIPConfigurations = append(frontendIPConfigurations[:index], frontendIPConfigurations[index+1:]...)
+	loadBalancer.LoadBalancerPropertiesFormat.FrontendIPConfigurations = &frontendIPConfigurations
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
+	if err != nil {
+		return fmt.Errorf("updating %s for deletion of %s: %+v", loadBalancerId, *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s for deletion of %s: %+v", loadBalancerId, *id, err)
+	}
+
+	return nil
+}
+
+func expandLoadBalancerFrontendIpConfiguration(d *pluginsdk.ResourceData) network.FrontendIPConfiguration {
+	properties := network.FrontendIPConfigurationPropertiesFormat{
+		PrivateIPAllocationMethod: network.IPAllocationMethod(d.Get("private_ip_address_allocation").(string)),
+	}
+
+	if v := d.Get("private_ip_address").(string); v != "" {
+		properties.PrivateIPAddress = pointer.FromString(v)
+	}
+
+	if v := d.Get("public_ip_address_id").(string); v != "" {
+		properties.PublicIPAddress = &network.PublicIPAddress{
+			ID: pointer.FromString(v),
+		}
+	}
+
+	if v := d.Get("subnet_id").(string); v != "" {
+		properties.Subnet = &network.Subnet{
+			ID: pointer.FromString(v),
+		}
+	}
+
+	return network.FrontendIPConfiguration{
+		Name:                                    pointer.FromString(d.Get("name").(string)),
+		FrontendIPConfigurationPropertiesFormat: &properties,
+	}
+}
+
+// setLoadBalancerFrontendIpConfigurationProperties is shared between the Resource and the Data Source
+func setLoadBalancerFrontendIpConfigurationProperties(d *pluginsdk.ResourceData, props *network.FrontendIPConfigurationPropertiesFormat) error {
+	d.Set("private_ip_address_allocation", string(props.PrivateIPAllocationMethod))
+	d.Set("private_ip_address", props.PrivateIPAddress)
+
+	subnetId := ""
+	if props.Subnet != nil && props.Subnet.ID != nil {
+		subnetId = *props.Subnet.ID
+	}
+	d.Set("subnet_id", subnetId)
+
+	publicIpAddressId := ""
+	if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+		publicIpAddressId = *props.PublicIPAddress.ID
+	}
+	d.Set("public_ip_address_id", publicIpAddressId)
+
+	loadBalancerRules := make([]string, 0)
+	if rules := props.LoadBalancingRules; rules != nil {
+		for _, rule := range *rules {
+			if rule.ID != nil {
+				loadBalancerRules = append(loadBalancerRules, *rule.ID)
+			}
+		}
+	}
+	if err := d.Set("load_balancer_rules", loadBalancerRules); err != nil {
+		return fmt.Errorf("setting `load_balancer_rules`: %+v", err)
+	}
+
+	inboundNatRules := make([]string, 0)
+	if rules := props.InboundNatRules; rules != nil {
+		for _, rule := range *rules {
+			if rule.ID != nil {
+				inboundNatRules = append(inboundNatRules, *rule.ID)
+			}
+		}
+	}
+	if err := d.Set("inbound_nat_rules", inboundNatRules); err != nil {
+		return fmt.Errorf("setting `inbound_nat_rules`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type LoadBalancerFrontendIpConfiguration struct{}
+
+func TestAccLoadBalancerFrontendIpConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfiguration{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLoadBalancerFrontendIpConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfiguration{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLoadBalancerFrontendIpConfiguration_privateIP(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfiguration{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateIP(data, "Dynamic", ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_ip_address").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.privateIP(data, "Static", "10.0.2.10"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_ip_address").HasValue("10.0.2.10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLoadBalancerFrontendIpConfiguration_loadBalancerUpdated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfiguration{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// updating the Load Balancer mustn't remove the externally managed Frontend IP Configuration
+			Config: r.loadBalancerTagged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurestack_lb.test").Key("frontend_ip_configuration.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		// there's no state to filter against during import, so the Load Balancer includes the externally managed Frontend IP Configuration
+		data.ImportStepFor("azurestack_lb.test", "frontend_ip_configuration"),
+	})
+}
+
+func TestAccLoadBalancerFrontendIpConfiguration_noInlineFrontendIpConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfiguration{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.noInlineFrontendIpConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurestack_lb.test").Key("frontend_ip_configuration.#").HasValue("0"),
+			),
+		},
+		{
+			// refreshing the Load Balancer mustn't take ownership of the externally managed Frontend IP Configuration,
+			// which would otherwise be removed by this apply and leave a non-empty plan
+			Config: r.noInlineFrontendIpConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurestack_lb.test").Key("frontend_ip_configuration.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LoadBalancerFrontendIpConfiguration) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LoadBalancerFrontendIpConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := client.LoadBalancer.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(lb.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Load Balancer for %s: %+v", *id, err)
+	}
+
+	_, _, exists := loadbalancer.FindLoadBalancerFrontEndIpConfigurationByName(&lb, id.FrontendIPConfigurationName)
+	return pointer.FromBool(exists), nil
+}
+
+func (r LoadBalancerFrontendIpConfiguration) template(data acceptance.TestData, tags string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    load_balancer {
+      ignore_externally_managed_frontend_ip_configurations = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "test-ip-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "arm-test-loadbalancer-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                 = "one-%[1]d"
+    public_ip_address_id = azurestack_public_ip.test.id
+  }
+%[3]s
+}
+`, data.RandomInteger, data.Locations.Primary, tags)
+}
+
+func (r LoadBalancerFrontendIpConfiguration) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurestack_public_ip" "second" {
+  name                = "test-ip-second-%[2]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_lb_frontend_ip_configuration" "test" {
+  name                 = "two-%[2]d"
+  loadbalancer_id      = azurestack_lb.test.id
+  public_ip_address_id = azurestack_public_ip.second.id
+}
+`, r.template(data, ""), data.RandomInteger)
+}
+
+func (r LoadBalancerFrontendIpConfiguration) noInlineFrontendIpConfiguration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    load_balancer {
+      ignore_externally_managed_frontend_ip_configurations = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "test-ip-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "arm-test-loadbalancer-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_lb_frontend_ip_configuration" "test" {
+  name                 = "one-%[1]d"
+  loadbalancer_id      = azurestack_lb.test.id
+  public_ip_address_id = azurestack_public_ip.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r LoadBalancerFrontendIpConfiguration) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_lb_frontend_ip_configuration" "import" {
+  name                 = azurestack_lb_frontend_ip_configuration.test.name
+  loadbalancer_id      = azurestack_lb_frontend_ip_configuration.test.loadbalancer_id
+  public_ip_address_id = azurestack_lb_frontend_ip_configuration.test.public_ip_address_id
+}
+`, r.basic(data))
+}
+
+func (r LoadBalancerFrontendIpConfiguration) loadBalancerTagged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurestack_public_ip" "second" {
+  name                = "test-ip-second-%[2]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_lb_frontend_ip_configuration" "test" {
+  name                 = "two-%[2]d"
+  loadbalancer_id      = azurestack_lb.test.id
+  public_ip_address_id = azurestack_public_ip.second.id
+}
+`, r.template(data, `
+  tags = {
+    Environment = "Test"
+  }
+`), data.RandomInteger)
+}
+
+func (r LoadBalancerFrontendIpConfiguration) privateIP(data acceptance.TestData, allocation, privateIPAddress string) string {
+	privateIPAddressBlock := ""
+	if privateIPAddress != "" {
+		privateIPAddressBlock = fmt.Sprintf("private_ip_address            = %q", privateIPAddress)
+	}
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctvn-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctsub-%[2]d"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_lb_frontend_ip_configuration" "test" {
+  name                          = "two-%[2]d"
+  loadbalancer_id               = azurestack_lb.test.id
+  subnet_id                     = azurestack_subnet.test.id
+  private_ip_address_allocation = "%[3]s"
+  %[4]s
+}
+`, r.template(data, ""), data.RandomInteger, allocation, privateIPAddressBlock)
+}
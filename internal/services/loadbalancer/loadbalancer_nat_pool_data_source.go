@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func loadBalancerNatPoolDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: loadBalancerNatPoolDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port_start": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"frontend_port_end": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"backend_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port_mappings": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"inbound_nat_rule_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"backend_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"backend_ip_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func loadBalancerNatPoolDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+	natRulesClient := meta.(*clients.Client).LoadBalancer.InboundNatRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewLoadBalancerInboundNatPoolID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+
+	loadBalancer, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			return fmt.Errorf("Error: %s was not found", *loadBalancerId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+	}
+
+	config, _, exists := FindLoadBalancerNatPoolByName(&loadBalancer, id.InboundNatPoolName)
+	if !exists {
+		return fmt.Errorf("Error: %s was not found", id)
+	}
+
+	d.SetId(id.ID())
+
+	if props := config.InboundNatPoolPropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+
+		frontendPortRangeStart := 0
+		if props.FrontendPortRangeStart != nil {
+			frontendPortRangeStart = int(*props.FrontendPortRangeStart)
+		}
+		d.Set("frontend_port_start", frontendPortRangeStart)
+
+		frontendPortRangeEnd := 0
+		if props.FrontendPortRangeEnd != nil {
+			frontendPortRangeEnd = int(*props.FrontendPortRangeEnd)
+		}
+		d.Set("frontend_port_end", frontendPortRangeEnd)
+
+		backendPort := 0
+		if props.BackendPort != nil {
+			backendPort = int(*props.BackendPort)
+		}
+		d.Set("backend_port", backendPort)
+
+		frontendIPConfigName := ""
+		frontendIPConfigID := ""
+		if props.FrontendIPConfiguration != nil && props.FrontendIPConfiguration.ID != nil {
+			feid, err := parse.LoadBalancerFrontendIpConfigurationID(*props.FrontendIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+
+			frontendIPConfigName = feid.FrontendIPConfigurationName
+			frontendIPConfigID = feid.ID()
+		}
+		d.Set("frontend_ip_configuration_name", frontendIPConfigName)
+		d.Set("frontend_ip_configuration_id", frontendIPConfigID)
+	}
+
+	rules, err := listLoadBalancerNatPoolInboundNatRules(ctx, natRulesClient, id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("frontend_port_mappings", flattenLoadBalancerNatPoolFrontendPortMappings(rules)); err != nil {
+		return fmt.Errorf("setting `frontend_port_mappings`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenLoadBalancerNatPoolFrontendPortMappings(input []network.InboundNatRule) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, rule := range input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		frontendPort := 0
+		backendPort := 0
+		backendIPConfigId := ""
+		if props := rule.InboundNatRulePropertiesFormat; props != nil {
+			if props.FrontendPort != nil {
+				frontendPort = int(*props.FrontendPort)
+			}
+			if props.BackendPort != nil {
+				backendPort = int(*props.BackendPort)
+			}
+			if props.BackendIPConfiguration != nil && props.BackendIPConfiguration.ID != nil {
+				backendIPConfigId = *props.BackendIPConfiguration.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"inbound_nat_rule_name":       name,
+			"frontend_port":               frontendPort,
+			"backend_port":                backendPort,
+			"backend_ip_configuration_id": backendIPConfigId,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/compute/mgmt/compute"
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	computeParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	networkParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func loadBalancerNatPoolInstanceMappingsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: loadBalancerNatPoolInstanceMappingsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"nat_pool_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"mappings": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"virtual_machine_scale_set_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"instance_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"computer_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"backend_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"inbound_nat_rule_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func loadBalancerNatPoolInstanceMappingsDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+	natRulesClient := meta.(*clients.Client).LoadBalancer.InboundNatRulesClient
+	publicIPsClient := meta.(*clients.Client).Network.PublicIPsClient
+	vmssVMsClient := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewLoadBalancerInboundNatPoolID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("nat_pool_name").(string))
+
+	loadBalancer, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			return fmt.Errorf("Error: %s was not found", *loadBalancerId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+	}
+
+	natPool, _, exists := FindLoadBalancerNatPoolByName(&loadBalancer, id.InboundNatPoolName)
+	if !exists {
+		return fmt.Errorf("Error: %s was not found", id)
+	}
+
+	frontendIPAddress := ""
+	if props := natPool.InboundNatPoolPropertiesFormat; props != nil && props.FrontendIPConfiguration != nil && props.FrontendIPConfiguration.ID != nil {
+		frontendIPAddress, err = loadBalancerFrontendIPAddress(ctx, publicIPsClient, &loadBalancer, *props.FrontendIPConfiguration.ID)
+		if err != nil {
+			return fmt.Errorf("determining the Frontend IP Address for %s: %+v", id, err)
+		}
+	}
+
+	rules, err := listLoadBalancerNatPoolInboundNatRules(ctx, natRulesClient, id)
+	if err != nil {
+		return err
+	}
+
+	// the Scale Set instances are found by matching the Network Interface which each Inbound NAT Rule routes to
+	instances := make(map[string]compute.VirtualMachineScaleSetVM)
+	scaleSets := make(map[string]computeParse.VirtualMachineScaleSetId)
+	mappings := make([]loadBalancerNatPoolInstanceMapping, 0)
+	for _, rule := range rules {
+		props := rule.InboundNatRulePropertiesFormat
+		if props == nil || props.BackendIPConfiguration == nil || props.BackendIPConfiguration.ID == nil {
+			continue
+		}
+
+		// Inbound NAT Rules created manually can match the NAT Pool's naming convention, so skip any which don't route to a Scale Set
+		scaleSetId, networkInterfaceId, err := parseScaleSetVMIPConfigurationID(*props.BackendIPConfiguration.ID)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping Inbound NAT Rule %q since its backend isn't a Scale Set instance: %+v", *rule.Name, err)
+			continue
+		}
+
+		if _, ok := scaleSets[scaleSetId.ID()]; !ok {
+			scaleSets[scaleSetId.ID()] = *scaleSetId
+
+			iterator, err := vmssVMsClient.ListComplete(ctx, scaleSetId.ResourceGroup, scaleSetId.Name, "", "", "")
+			if err != nil {
+				return fmt.Errorf("listing instances for %s: %+v", *scaleSetId, err)
+			}
+			for iterator.NotDone() {
+				vm := iterator.Value()
+				if vm.VirtualMachineScaleSetVMProperties != nil && vm.VirtualMachineScaleSetVMProperties.NetworkProfile != nil && vm.VirtualMachineScaleSetVMProperties.NetworkProfile.NetworkInterfaces != nil {
+					for _, nic := range *vm.VirtualMachineScaleSetVMProperties.NetworkProfile.NetworkInterfaces {
+						if nic.ID != nil {
+							instances[strings.ToLower(*nic.ID)] = vm
+						}
+					}
+				}
+
+				if err := iterator.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("listing instances for %s: %+v", *scaleSetId, err)
+				}
+			}
+		}
+
+		mapping := loadBalancerNatPoolInstanceMapping{
+			scaleSetId:        scaleSetId.ID(),
+			frontendIPAddress: frontendIPAddress,
+		}
+		if rule.Name != nil {
+			mapping.ruleName = *rule.Name
+		}
+		if props.FrontendPort != nil {
+			mapping.frontendPort = int(*props.FrontendPort)
+		}
+		if props.BackendPort != nil {
+			mapping.backendPort = int(*props.BackendPort)
+		}
+		if vm, ok := instances[strings.ToLower(networkInterfaceId)]; ok {
+			if vm.InstanceID != nil {
+				mapping.instanceId = *vm.InstanceID
+			}
+			if vm.VirtualMachineScaleSetVMProperties != nil && vm.VirtualMachineScaleSetVMProperties.OsProfile != nil && vm.VirtualMachineScaleSetVMProperties.OsProfile.ComputerName != nil {
+				mapping.computerName = *vm.VirtualMachineScaleSetVMProperties.OsProfile.ComputerName
+			}
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("mappings", flattenLoadBalancerNatPoolInstanceMappings(mappings)); err != nil {
+		return fmt.Errorf("setting `mappings`: %+v", err)
+	}
+
+	return nil
+}
+
+type loadBalancerNatPoolInstanceMapping struct {
+	scaleSetId        string
+	instanceId        string
+	computerName      string
+	frontendIPAddress string
+	frontendPort      int
+	backendPort       int
+	ruleName          string
+}
+
+func flattenLoadBalancerNatPoolInstanceMappings(input []loadBalancerNatPoolInstanceMapping) []interface{} {
+	sort.Slice(input, func(i, j int) bool {
+		return input[i].frontendPort < input[j].frontendPort
+	})
+
+	results := make([]interface{}, 0)
+	for _, v := range input {
+		results = append(results, map[string]interface{}{
+			"virtual_machine_scale_set_id": v.scaleSetId,
+			"instance_id":                  v.instanceId,
+			"computer_name":                v.computerName,
+			"frontend_ip_address":          v.frontendIPAddress,
+			"frontend_port":                v.frontendPort,
+			"backend_port":                 v.backendPort,
+			"inbound_nat_rule_name":        v.ruleName,
+		})
+	}
+
+	return results
+}
+
+// loadBalancerFrontendIPAddress returns the IP Address of the specified Frontend IP Configuration, which is either
+// the Private IP Address or the IP Address of the associated Public IP
+func loadBalancerFrontendIPAddress(ctx context.Context, client *network.PublicIPAddressesClient, lb *network.LoadBalancer, frontendIPConfigurationId string) (string, error) {
+	feid, err := parse.LoadBalancerFrontendIpConfigurationID(frontendIPConfigurationId)
+	if err != nil {
+		return "", err
+	}
+
+	config, _, exists := FindLoadBalancerFrontEndIpConfigurationByName(lb, feid.FrontendIPConfigurationName)
+	if !exists || config.FrontendIPConfigurationPropertiesFormat == nil {
+		return "", fmt.Errorf("%s was not found", *feid)
+	}
+	props := config.FrontendIPConfigurationPropertiesFormat
+
+	if props.PrivateIPAddress != nil && *props.PrivateIPAddress != "" {
+		return *props.PrivateIPAddress, nil
+	}
+
+	if props.PublicIPAddress == nil || props.PublicIPAddress.ID == nil {
+		return "", nil
+	}
+
+	publicIpId, err := networkParse.PublicIpAddressID(*props.PublicIPAddress.ID)
+	if err != nil {
+		return "", err
+	}
+
+	publicIp, err := client.Get(ctx, publicIpId.ResourceGroup, publicIpId.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", *publicIpId, err)
+	}
+
+	if publicIp.PublicIPAddressPropertiesFormat != nil && publicIp.PublicIPAddressPropertiesFormat.IPAddress != nil {
+		return *publicIp.PublicIPAddressPropertiesFormat.IPAddress, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type LoadBalancerNatPoolInstanceMappingsDataSource struct{}
+
+func TestAccLoadBalancerNatPoolInstanceMappingsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_lb_nat_pool_instance_mappings", "test")
+	r := LoadBalancerNatPoolInstanceMappingsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("mappings.#").HasValue("2"),
+				check.That(data.ResourceName).Key("mappings.0.instance_id").Exists(),
+				check.That(data.ResourceName).Key("mappings.0.computer_name").Exists(),
+				check.That(data.ResourceName).Key("mappings.0.frontend_ip_address").Exists(),
+				check.That(data.ResourceName).Key("mappings.0.frontend_port").HasValue("50000"),
+				check.That(data.ResourceName).Key("mappings.0.backend_port").HasValue("22"),
+				check.That(data.ResourceName).Key("mappings.1.frontend_port").HasValue("50001"),
+			),
+		},
+	})
+}
+
+func (LoadBalancerNatPoolInstanceMappingsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestnw-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "test-ip-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "acctestlb-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                 = "internal"
+    public_ip_address_id = azurestack_public_ip.test.id
+  }
+}
+
+resource "azurestack_lb_backend_address_pool" "test" {
+  name            = "test"
+  loadbalancer_id = azurestack_lb.test.id
+}
+
+resource "azurestack_lb_nat_pool" "test" {
+  name                           = "ssh"
+  resource_group_name            = azurestack_resource_group.test.name
+  loadbalancer_id                = azurestack_lb.test.id
+  frontend_ip_configuration_name = "internal"
+  protocol                       = "Tcp"
+  frontend_port_start            = 50000
+  frontend_port_end              = 50119
+  backend_port                   = 22
+}
+
+resource "azurestack_linux_virtual_machine_scale_set" "test" {
+  name                = "acctestvmss-%[1]d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+  sku                 = "Standard_F2"
+  instances           = 2
+  admin_username      = "adminuser"
+  admin_password      = "P@ssword1234!"
+
+  disable_password_authentication = false
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name                                   = "internal"
+      primary                                = true
+      subnet_id                              = azurestack_subnet.test.id
+      load_balancer_backend_address_pool_ids = [azurestack_lb_backend_address_pool.test.id]
+      load_balancer_inbound_nat_rules_ids    = [azurestack_lb_nat_pool.test.id]
+    }
+  }
+}
+
+data "azurestack_lb_nat_pool_instance_mappings" "test" {
+  nat_pool_name   = azurestack_lb_nat_pool.test.name
+  loadbalancer_id = azurestack_lb.test.id
+
+  depends_on = [azurestack_linux_virtual_machine_scale_set.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func loadBalancerNatRuleDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: loadBalancerNatRuleDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"backend_port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"backend_ip_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"enable_floating_ip": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"enable_tcp_reset": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"idle_timeout_in_minutes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func loadBalancerNatRuleDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.InboundNatRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewLoadBalancerInboundNatRuleID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.InboundNatRuleName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: %s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.InboundNatRulePropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+
+		frontendPort := 0
+		if props.FrontendPort != nil {
+			frontendPort = int(*props.FrontendPort)
+		}
+		d.Set("frontend_port", frontendPort)
+
+		backendPort := 0
+		if props.BackendPort != nil {
+			backendPort = int(*props.BackendPort)
+		}
+		d.Set("backend_port", backendPort)
+
+		frontendIPConfigName := ""
+		frontendIPConfigID := ""
+		if props.FrontendIPConfiguration != nil && props.FrontendIPConfiguration.ID != nil {
+			feid, err := parse.LoadBalancerFrontendIpConfigurationID(*props.FrontendIPConfiguration.ID)
+			if err != nil {
+				return err
+			}
+
+			frontendIPConfigName = feid.FrontendIPConfigurationName
+			frontendIPConfigID = feid.ID()
+		}
+		d.Set("frontend_ip_configuration_name", frontendIPConfigName)
+		d.Set("frontend_ip_configuration_id", frontendIPConfigID)
+
+		backendIPConfigId := ""
+		if props.BackendIPConfiguration != nil && props.BackendIPConfiguration.ID != nil {
+			backendIPConfigId = *props.BackendIPConfiguration.ID
+		}
+		d.Set("backend_ip_configuration_id", backendIPConfigId)
+
+		d.Set("enable_floating_ip", utils.NormaliseNilableBool(props.EnableFloatingIP))
+		d.Set("enable_tcp_reset", utils.NormaliseNilableBool(props.EnableTCPReset))
+
+		idleTimeoutInMinutes := 0
+		if props.IdleTimeoutInMinutes != nil {
+			idleTimeoutInMinutes = int(*props.IdleTimeoutInMinutes)
+		}
+		d.Set("idle_timeout_in_minutes", idleTimeoutInMinutes)
+	}
+
+	return nil
+}
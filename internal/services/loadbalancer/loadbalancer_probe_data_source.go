@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func loadBalancerProbeDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: loadBalancerProbeDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"request_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"interval_in_seconds": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"number_of_probes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"load_balancer_rules": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func loadBalancerProbeDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancerProbesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewLoadBalancerProbeID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.ProbeName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: %s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.ProbePropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+		d.Set("request_path", props.RequestPath)
+
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		d.Set("port", port)
+
+		intervalInSeconds := 0
+		if props.IntervalInSeconds != nil {
+			intervalInSeconds = int(*props.IntervalInSeconds)
+		}
+		d.Set("interval_in_seconds", intervalInSeconds)
+
+		numberOfProbes := 0
+		if props.NumberOfProbes != nil {
+			numberOfProbes = int(*props.NumberOfProbes)
+		}
+		d.Set("number_of_probes", numberOfProbes)
+
+		loadBalancerRules := make([]string, 0)
+		if rules := props.LoadBalancingRules; rules != nil {
+			for _, rule := range *rules {
+				if rule.ID != nil {
+					loadBalancerRules = append(loadBalancerRules, *rule.ID)
+				}
+			}
+		}
+		if err := d.Set("load_balancer_rules", loadBalancerRules); err != nil {
+			return fmt.Errorf("setting `load_balancer_rules`: %+v", err)
+		}
+	}
+
+	return nil
+}
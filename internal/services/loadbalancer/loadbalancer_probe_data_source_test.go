@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+func TestAccLoadBalancerProbeDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_lb_probe", "test")
+	r := LoadBalancerProbe{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basicDataSource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("port").Exists(),
+			),
+		},
+	})
+}
+
+func (r LoadBalancerProbe) basicDataSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_lb_probe" "test" {
+  name            = azurestack_lb_probe.test.name
+  loadbalancer_id = azurestack_lb_probe.test.loadbalancer_id
+}
+`, r.basic(data))
+}
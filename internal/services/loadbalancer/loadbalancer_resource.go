@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
@@ -33,10 +34,10 @@ func loadBalancer() *pluginsdk.Resource {
 		Update: loadBalancerCreateUpdate,
 		Delete: loadBalancerDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.LoadBalancerID(id)
 			return err
-		}),
+		}, loadBalancerImport),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -197,6 +198,9 @@ func loadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	properties := network.LoadBalancerPropertiesFormat{}
 
 	if _, ok := d.GetOk("frontend_ip_configuration"); ok {
@@ -204,6 +208,38 @@ func loadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		properties.FrontendIPConfigurations = frontendIPConfigurations
 	}
 
+	if meta.(*clients.Client).Features.LoadBalancer.IgnoreExternallyManagedFrontendIPConfigurations && !d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// Frontend IP Configurations which have never been defined on this resource are managed elsewhere
+		// (e.g. by `azurestack_lb_frontend_ip_configuration`) and so need to be retained - since Read only ever
+		// filters the state the previous names are those which were defined inline (or explicitly imported)
+		old, _ := d.GetChange("frontend_ip_configuration")
+		managed := loadBalancerFrontendIpConfigurationNames(old.([]interface{}))
+		for k := range loadBalancerFrontendIpConfigurationNames(d.Get("frontend_ip_configuration").([]interface{})) {
+			managed[k] = struct{}{}
+		}
+
+		frontendIPConfigurations := make([]network.FrontendIPConfiguration, 0)
+		if properties.FrontendIPConfigurations != nil {
+			frontendIPConfigurations = *properties.FrontendIPConfigurations
+		}
+		if existing.LoadBalancerPropertiesFormat != nil && existing.LoadBalancerPropertiesFormat.FrontendIPConfigurations != nil {
+			for _, config := range *existing.LoadBalancerPropertiesFormat.FrontendIPConfigurations {
+				if config.Name == nil {
+					continue
+				}
+				if _, ok := managed[*config.Name]; !ok {
+					frontendIPConfigurations = append(frontendIPConfigurations, config)
+				}
+			}
+		}
+		properties.FrontendIPConfigurations = &frontendIPConfigurations
+	}
+
 	loadBalancer := network.LoadBalancer{
 		Name:     pointer.FromString(id.Name),
 		Location: pointer.FromString(location.Normalize(d.Get("location").(string))),
@@ -227,6 +263,30 @@ func loadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	return loadBalancerRead(d, meta)
 }
 
+// loadBalancerImport populates the names of all of the Frontend IP Configurations, since when the
+// `ignore_externally_managed_frontend_ip_configurations` feature is enabled Read only retains those within the state
+func loadBalancerImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
+
+	id, err := parse.LoadBalancerID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.LoadBalancerPropertiesFormat; props != nil && props.FrontendIPConfigurations != nil {
+		if err := d.Set("frontend_ip_configuration", flattenLoadBalancerFrontendIpConfiguration(props.FrontendIPConfigurations)); err != nil {
+			return []*pluginsdk.ResourceData{d}, fmt.Errorf("setting `frontend_ip_configuration`: %+v", err)
+		}
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func loadBalancerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).LoadBalancer.LoadBalancersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -257,7 +317,13 @@ func loadBalancerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	if props := resp.LoadBalancerPropertiesFormat; props != nil {
 		if feipConfigs := props.FrontendIPConfigurations; feipConfigs != nil {
-			if err := d.Set("frontend_ip_configuration", flattenLoadBalancerFrontendIpConfiguration(feipConfigs)); err != nil {
+			flattenedConfigs := flattenLoadBalancerFrontendIpConfiguration(feipConfigs)
+			// only the Frontend IP Configurations already in the state are retained, even when there's none, so that
+			// Read never takes ownership of those managed elsewhere - importing is handled by `loadBalancerImport`
+			if meta.(*clients.Client).Features.LoadBalancer.IgnoreExternallyManagedFrontendIPConfigurations {
+				flattenedConfigs = filterLoadBalancerFrontendIpConfigurations(flattenedConfigs, loadBalancerFrontendIpConfigurationNames(d.Get("frontend_ip_configuration").([]interface{})))
+			}
+			if err := d.Set("frontend_ip_configuration", flattenedConfigs); err != nil {
 				return fmt.Errorf("flattening `frontend_ip_configuration`: %+v", err)
 			}
 
@@ -399,3 +465,30 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 	}
 	return result
 }
+
+func loadBalancerFrontendIpConfigurationNames(input []interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, raw := range input {
+		if config, ok := raw.(map[string]interface{}); ok {
+			if name, ok := config["name"].(string); ok && name != "" {
+				names[name] = struct{}{}
+			}
+		}
+	}
+	return names
+}
+
+// filterLoadBalancerFrontendIpConfigurations returns only the Frontend IP Configurations with the specified names,
+// so that those managed outside of the `azurestack_lb` resource aren't detected as drift
+func filterLoadBalancerFrontendIpConfigurations(input []interface{}, names map[string]struct{}) []interface{} {
+	result := make([]interface{}, 0)
+	for _, raw := range input {
+		config := raw.(map[string]interface{})
+		if name, ok := config["name"].(string); ok {
+			if _, managed := names[name]; managed {
+				result = append(result, config)
+			}
+		}
+	}
+	return result
+}
@@ -79,6 +79,22 @@ func TestAccLoadBalancer_frontEndConfig(t *testing.T) {
 	})
 }
 
+func TestAccLoadBalancer_dualStackFrontEndConfig(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb", "test")
+	r := LoadBalancer{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dualStackFrontEndConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("frontend_ip_configuration.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccLoadBalancer_tags(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_lb", "test")
 	r := LoadBalancer{}
@@ -260,6 +276,50 @@ resource "azurestack_lb" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (r LoadBalancer) dualStackFrontEndConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-lb-%d"
+  location = "%s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "test-ip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurestack_public_ip" "test1" {
+  name                = "test-ipv6-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+  ip_version          = "IPv6"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "acctest-loadbalancer-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                 = "ipv4"
+    public_ip_address_id = azurestack_public_ip.test.id
+  }
+
+  frontend_ip_configuration {
+    name                 = "ipv6"
+    public_ip_address_id = azurestack_public_ip.test1.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (r LoadBalancer) frontEndConfigRemovalWithIP(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -32,20 +32,26 @@ func (r Registration) DataSources() []sdk.DataSource {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_lb":                      loadBalancerDataSource(),
-		"azurestack_lb_backend_address_pool": loadBalancerBackendAddressPoolDataSource(),
-		"azurestack_lb_rule":                 loadBalancerRuleDataSource(),
+		"azurestack_lb":                            loadBalancerDataSource(),
+		"azurestack_lb_backend_address_pool":       loadBalancerBackendAddressPoolDataSource(),
+		"azurestack_lb_frontend_ip_configuration":  loadBalancerFrontendIpConfigurationDataSource(),
+		"azurestack_lb_nat_pool":                   loadBalancerNatPoolDataSource(),
+		"azurestack_lb_nat_pool_instance_mappings": loadBalancerNatPoolInstanceMappingsDataSource(),
+		"azurestack_lb_nat_rule":                   loadBalancerNatRuleDataSource(),
+		"azurestack_lb_probe":                      loadBalancerProbeDataSource(),
+		"azurestack_lb_rule":                       loadBalancerRuleDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_lb_backend_address_pool": loadBalancerBackendAddressPool(),
-		"azurestack_lb_nat_pool":             loadBalancerNatPool(),
-		"azurestack_lb_nat_rule":             loadBalancerNatRule(),
-		"azurestack_lb_probe":                loadBalancerProbe(),
-		"azurestack_lb_rule":                 loadBalancerRule(),
-		"azurestack_lb":                      loadBalancer(),
+		"azurestack_lb_backend_address_pool":      loadBalancerBackendAddressPool(),
+		"azurestack_lb_frontend_ip_configuration": loadBalancerFrontendIpConfiguration(),
+		"azurestack_lb_nat_pool":                  loadBalancerNatPool(),
+		"azurestack_lb_nat_rule":                  loadBalancerNatRule(),
+		"azurestack_lb_probe":                     loadBalancerProbe(),
+		"azurestack_lb_rule":                      loadBalancerRule(),
+		"azurestack_lb":                           loadBalancer(),
 	}
 }
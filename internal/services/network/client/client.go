@@ -13,11 +13,14 @@ type Client struct {
 	InterfacesClient                *network.InterfacesClient
 	LocalNetworkGatewaysClient      *network.LocalNetworkGatewaysClient
 	PublicIPsClient                 *network.PublicIPAddressesClient
+	RouteFiltersClient              *network.RouteFiltersClient
+	RouteFilterRulesClient          *network.RouteFilterRulesClient
 	RoutesClient                    *network.RoutesClient
 	RouteTablesClient               *network.RouteTablesClient
 	SecurityGroupClient             *network.SecurityGroupsClient
 	SecurityRuleClient              *network.SecurityRulesClient
 	SubnetsClient                   *network.SubnetsClient
+	UsagesClient                    *network.UsagesClient
 	VnetGatewayConnectionsClient    *network.VirtualNetworkGatewayConnectionsClient
 	VnetGatewayClient               *network.VirtualNetworkGatewaysClient
 	VnetClient                      *network.VirtualNetworksClient
@@ -67,6 +70,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteFiltersClient := network.NewRouteFiltersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteFiltersClient.Client, o.ResourceManagerAuthorizer)
 
+	RouteFilterRulesClient := network.NewRouteFilterRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RouteFilterRulesClient.Client, o.ResourceManagerAuthorizer)
+
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -79,6 +85,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	SubnetsClient := network.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SubnetsClient.Client, o.ResourceManagerAuthorizer)
 
+	UsagesClient := network.NewUsagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&UsagesClient.Client, o.ResourceManagerAuthorizer)
+
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
@@ -93,11 +102,14 @@ func NewClient(o *common.ClientOptions) *Client {
 		InterfacesClient:                &InterfacesClient,
 		LocalNetworkGatewaysClient:      &LocalNetworkGatewaysClient,
 		PublicIPsClient:                 &PublicIPsClient,
+		RouteFiltersClient:              &RouteFiltersClient,
+		RouteFilterRulesClient:          &RouteFilterRulesClient,
 		RoutesClient:                    &RoutesClient,
 		RouteTablesClient:               &RouteTablesClient,
 		SecurityGroupClient:             &SecurityGroupClient,
 		SecurityRuleClient:              &SecurityRuleClient,
 		SubnetsClient:                   &SubnetsClient,
+		UsagesClient:                    &UsagesClient,
 		VnetGatewayConnectionsClient:    &VnetGatewayConnectionsClient,
 		VnetGatewayClient:               &VnetGatewayClient,
 		VnetClient:                      &VnetClient,
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"net"
+)
+
+// azureReservedAddressesAtStartOfSubnet is the number of addresses at the start of every subnet
+// which are reserved by the platform (network address, default gateway and two DNS addresses)
+const azureReservedAddressesAtStartOfSubnet = 4
+
+// usableIPv4AddressRange returns the first and last IPv4 addresses within the specified CIDR
+// which can be assigned to a Network Interface, taking into account the addresses reserved
+// by the platform at the start and end (broadcast) of the subnet
+func usableIPv4AddressRange(cidr string) (net.IP, net.IP, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing %q as a CIDR: %+v", cidr, err)
+	}
+
+	network := ipNet.IP.To4()
+	if network == nil {
+		return nil, nil, fmt.Errorf("%q is not an IPv4 CIDR", cidr)
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	size := uint64(1) << uint(bits-ones)
+	if size <= azureReservedAddressesAtStartOfSubnet+1 {
+		return nil, nil, fmt.Errorf("%q does not contain any usable addresses", cidr)
+	}
+
+	start := ipv4ToUint32(network)
+	first := uint32ToIPv4(start + azureReservedAddressesAtStartOfSubnet)
+	last := uint32ToIPv4(start + uint32(size-2))
+	return first, last, nil
+}
+
+// nextIPv4Address returns the address following the specified IPv4 address
+func nextIPv4Address(ip net.IP) net.IP {
+	return uint32ToIPv4(ipv4ToUint32(ip.To4()) + 1)
+}
+
+// compareIPv4Addresses returns -1, 0 or 1 depending on whether `a` is lower than, equal to
+// or greater than `b`
+func compareIPv4Addresses(a, b net.IP) int {
+	x := ipv4ToUint32(a.To4())
+	y := ipv4ToUint32(b.To4())
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func ipv4ToUint32(ip net.IP) uint32 {
+	return uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3])
+}
+
+func uint32ToIPv4(v uint32) net.IP {
+	return net.IPv4(byte(v>>24), byte(v>>16), byte(v>>8), byte(v)).To4()
+}
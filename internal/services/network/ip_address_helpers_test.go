@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"net"
+	"testing"
+)
+
+func TestUsableIPv4AddressRange(t *testing.T) {
+	testData := []struct {
+		Input string
+		First string
+		Last  string
+		Error bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "2001:db8::/64",
+			Error: true,
+		},
+		{
+			Input: "10.0.0.0/30",
+			Error: true,
+		},
+		{
+			Input: "10.0.0.0/29",
+			First: "10.0.0.4",
+			Last:  "10.0.0.6",
+		},
+		{
+			Input: "10.0.2.0/24",
+			First: "10.0.2.4",
+			Last:  "10.0.2.254",
+		},
+		{
+			Input: "10.0.2.17/23",
+			First: "10.0.2.4",
+			Last:  "10.0.3.254",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		first, last, err := usableIPv4AddressRange(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if first.String() != v.First {
+			t.Fatalf("expected first address to be %q but got %q", v.First, first.String())
+		}
+		if last.String() != v.Last {
+			t.Fatalf("expected last address to be %q but got %q", v.Last, last.String())
+		}
+	}
+}
+
+func TestNextIPv4Address(t *testing.T) {
+	testData := map[string]string{
+		"10.0.0.4":       "10.0.0.5",
+		"10.0.0.255":     "10.0.1.0",
+		"10.255.255.255": "11.0.0.0",
+	}
+
+	for input, expected := range testData {
+		actual := nextIPv4Address(net.ParseIP(input)).String()
+		if actual != expected {
+			t.Fatalf("expected the address after %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestCompareIPv4Addresses(t *testing.T) {
+	if v := compareIPv4Addresses(net.ParseIP("10.0.0.4"), net.ParseIP("10.0.0.5")); v != -1 {
+		t.Fatalf("expected -1 but got %d", v)
+	}
+	if v := compareIPv4Addresses(net.ParseIP("10.0.1.0"), net.ParseIP("10.0.0.255")); v != 1 {
+		t.Fatalf("expected 1 but got %d", v)
+	}
+	if v := compareIPv4Addresses(net.ParseIP("10.0.0.4"), net.ParseIP("10.0.0.4")); v != 0 {
+		t.Fatalf("expected 0 but got %d", v)
+	}
+}
@@ -87,6 +87,7 @@ func networkInterface() *pluginsdk.Resource {
 							Default:  string(network.IPv4),
 							ValidateFunc: validation.StringInSlice([]string{
 								string(network.IPv4),
+								string(network.IPv6),
 							}, false),
 						},
 
@@ -171,6 +172,8 @@ func networkInterface() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkUsageLimitCustomizeDiff(networkUsageNameNetworkInterfaces)),
 	}
 }
 
@@ -545,6 +548,14 @@ func expandNetworkInterfaceIPConfigurations(input []interface{}) (*[]network.Int
 		}
 	}
 
+	// the primary IP Configuration has to be IPv4, IPv6 can only be used for secondary IP Configurations
+	for _, config := range ipConfigs {
+		isPrimary := len(ipConfigs) == 1 || (config.Primary != nil && *config.Primary)
+		if isPrimary && config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddressVersion == network.IPv6 {
+			return nil, fmt.Errorf("The primary `ip_configuration` must use a `private_ip_address_version` of `IPv4`.")
+		}
+	}
+
 	return &ipConfigs, nil
 }
 
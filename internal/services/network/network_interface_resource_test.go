@@ -124,6 +124,21 @@ func TestAccNetworkInterface_multipleIPConfigurationsSecondaryAsPrimary(t *testi
 	})
 }
 
+func TestAccNetworkInterface_dualStack(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dualStack(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configuration.1.private_ip_address_version").HasValue("IPv6"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetworkInterface_publicIP(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_network_interface", "test")
 	r := NetworkInterfaceResource{}
@@ -467,6 +482,53 @@ resource "azurestack_network_interface" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (NetworkInterfaceResource) dualStack(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+  address_space       = ["10.0.0.0/16", "ace:cab:deca::/48"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24", "ace:cab:deca:deed::/64"]
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+    primary                       = true
+  }
+
+  ip_configuration {
+    name                          = "ipv6"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+    private_ip_address_version    = "IPv6"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (r NetworkInterfaceResource) publicIP(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
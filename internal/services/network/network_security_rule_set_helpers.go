@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+)
+
+// networkSecurityRuleSetSeparator separates the name of the Rule Set from the name of the Rule within the
+// name of the Security Rule in Azure, Rule Set names can't contain this so that the prefixes can't overlap
+const networkSecurityRuleSetSeparator = "."
+
+func networkSecurityRuleSetRuleName(ruleSetName, ruleName string) string {
+	return ruleSetName + networkSecurityRuleSetSeparator + ruleName
+}
+
+// splitNetworkSecurityRulesByRuleSet splits the Security Rules within a Network Security Group into those
+// owned by the specified Rule Set and those which are managed elsewhere
+func splitNetworkSecurityRulesByRuleSet(rules *[]network.SecurityRule, ruleSetName string) (owned []network.SecurityRule, others []network.SecurityRule) {
+	owned = make([]network.SecurityRule, 0)
+	others = make([]network.SecurityRule, 0)
+	if rules == nil {
+		return owned, others
+	}
+
+	prefix := ruleSetName + networkSecurityRuleSetSeparator
+	for _, rule := range *rules {
+		if rule.Name != nil && strings.HasPrefix(*rule.Name, prefix) {
+			owned = append(owned, rule)
+			continue
+		}
+		others = append(others, rule)
+	}
+
+	return owned, others
+}
+
+// allocateNetworkSecurityRuleSetPriorities assigns each rule a priority within the range `start` to `end` in the
+// order the rules are specified - since priorities only need to be unique per direction each direction is
+// allocated separately, skipping over any priorities already in use by rules outside of the Rule Set
+func allocateNetworkSecurityRuleSetPriorities(rules []network.SecurityRule, others []network.SecurityRule, start, end int32) error {
+	used := make(map[network.SecurityRuleDirection]map[int32]struct{})
+	for _, rule := range others {
+		props := rule.SecurityRulePropertiesFormat
+		if props == nil || props.Priority == nil {
+			continue
+		}
+
+		direction := normalizeSecurityRuleDirection(props.Direction)
+		if _, ok := used[direction]; !ok {
+			used[direction] = make(map[int32]struct{})
+		}
+		used[direction][*props.Priority] = struct{}{}
+	}
+
+	next := make(map[network.SecurityRuleDirection]int32)
+	for i, rule := range rules {
+		props := rule.SecurityRulePropertiesFormat
+		if props == nil {
+			return fmt.Errorf("`properties` was nil for rule %d", i)
+		}
+
+		direction := normalizeSecurityRuleDirection(props.Direction)
+		priority, ok := next[direction]
+		if !ok {
+			priority = start
+		}
+		for {
+			if _, inUse := used[direction][priority]; !inUse {
+				break
+			}
+			priority++
+		}
+
+		if priority > end {
+			return fmt.Errorf("there are no free %s priorities left between %d and %d for rule %q", direction, start, end, *rule.Name)
+		}
+
+		p := priority
+		props.Priority = &p
+		next[direction] = priority + 1
+	}
+
+	return nil
+}
+
+func normalizeSecurityRuleDirection(input network.SecurityRuleDirection) network.SecurityRuleDirection {
+	if strings.EqualFold(string(input), string(network.SecurityRuleDirectionOutbound)) {
+		return network.SecurityRuleDirectionOutbound
+	}
+	return network.SecurityRuleDirectionInbound
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func TestSplitNetworkSecurityRulesByRuleSet(t *testing.T) {
+	rules := []network.SecurityRule{
+		{Name: utils.String("web.http")},
+		{Name: utils.String("web-api.http")},
+		{Name: utils.String("web")},
+		{Name: utils.String("manual")},
+		{Name: utils.String("web.https")},
+	}
+
+	owned, others := splitNetworkSecurityRulesByRuleSet(&rules, "web")
+	if len(owned) != 2 || *owned[0].Name != "web.http" || *owned[1].Name != "web.https" {
+		t.Fatalf("expected `web.http` and `web.https` to be owned but got %+v", owned)
+	}
+	if len(others) != 3 {
+		t.Fatalf("expected 3 other rules but got %d", len(others))
+	}
+
+	owned, others = splitNetworkSecurityRulesByRuleSet(nil, "web")
+	if len(owned) != 0 || len(others) != 0 {
+		t.Fatalf("expected no rules for a nil input")
+	}
+}
+
+func TestAllocateNetworkSecurityRuleSetPriorities(t *testing.T) {
+	newRule := func(name string, direction network.SecurityRuleDirection, priority *int32) network.SecurityRule {
+		return network.SecurityRule{
+			Name: utils.String(name),
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Direction: direction,
+				Priority:  priority,
+			},
+		}
+	}
+	p := func(v int32) *int32 {
+		return &v
+	}
+
+	testData := []struct {
+		Name     string
+		Rules    []network.SecurityRule
+		Others   []network.SecurityRule
+		Start    int32
+		End      int32
+		Expected []int32
+		Error    bool
+	}{
+		{
+			Name: "sequential",
+			Rules: []network.SecurityRule{
+				newRule("a", network.SecurityRuleDirectionInbound, nil),
+				newRule("b", network.SecurityRuleDirectionInbound, nil),
+				newRule("c", network.SecurityRuleDirectionInbound, nil),
+			},
+			Start:    200,
+			End:      299,
+			Expected: []int32{200, 201, 202},
+		},
+		{
+			Name: "directions are allocated separately",
+			Rules: []network.SecurityRule{
+				newRule("a", network.SecurityRuleDirectionInbound, nil),
+				newRule("b", network.SecurityRuleDirectionOutbound, nil),
+				newRule("c", "inbound", nil),
+			},
+			Start:    200,
+			End:      299,
+			Expected: []int32{200, 200, 201},
+		},
+		{
+			Name: "skips priorities used elsewhere",
+			Rules: []network.SecurityRule{
+				newRule("a", network.SecurityRuleDirectionInbound, nil),
+				newRule("b", network.SecurityRuleDirectionInbound, nil),
+				newRule("c", network.SecurityRuleDirectionOutbound, nil),
+			},
+			Others: []network.SecurityRule{
+				newRule("x", network.SecurityRuleDirectionInbound, p(200)),
+				newRule("y", network.SecurityRuleDirectionInbound, p(202)),
+				newRule("z", network.SecurityRuleDirectionInbound, p(4000)),
+			},
+			Start:    200,
+			End:      299,
+			Expected: []int32{201, 203, 200},
+		},
+		{
+			Name: "existing priorities are replaced",
+			Rules: []network.SecurityRule{
+				newRule("a", network.SecurityRuleDirectionInbound, p(150)),
+			},
+			Start:    300,
+			End:      300,
+			Expected: []int32{300},
+		},
+		{
+			Name: "range exhausted",
+			Rules: []network.SecurityRule{
+				newRule("a", network.SecurityRuleDirectionInbound, nil),
+				newRule("b", network.SecurityRuleDirectionInbound, nil),
+			},
+			Others: []network.SecurityRule{
+				newRule("x", network.SecurityRuleDirectionInbound, p(101)),
+			},
+			Start: 100,
+			End:   101,
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := allocateNetworkSecurityRuleSetPriorities(v.Rules, v.Others, v.Start, v.End)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		for i, rule := range v.Rules {
+			if actual := *rule.SecurityRulePropertiesFormat.Priority; actual != v.Expected[i] {
+				t.Fatalf("expected rule %q to have priority %d but got %d", *rule.Name, v.Expected[i], actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func networkSecurityRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: networkSecurityRuleSetCreateUpdate,
+		Read:   networkSecurityRuleSetRead,
+		Update: networkSecurityRuleSetCreateUpdate,
+		Delete: networkSecurityRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkSecurityRuleSetID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityRuleSetName,
+			},
+
+			"network_security_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityGroupID,
+			},
+
+			"priority_start": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 4096),
+			},
+
+			"priority_end": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 4096),
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 140),
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleProtocolAsterisk),
+								string(network.SecurityRuleProtocolTCP),
+								string(network.SecurityRuleProtocolUDP),
+							}, true),
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"source_port_range": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"source_port_ranges": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+							Set:      pluginsdk.HashString,
+						},
+
+						"destination_port_range": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"destination_port_ranges": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+							Set:      pluginsdk.HashString,
+						},
+
+						"source_address_prefix": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"source_address_prefixes": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+							Set:      pluginsdk.HashString,
+						},
+
+						"destination_address_prefix": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"destination_address_prefixes": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+							Set:      pluginsdk.HashString,
+						},
+
+						"access": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleAccessAllow),
+								string(network.SecurityRuleAccessDeny),
+							}, true),
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"direction": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.SecurityRuleDirectionInbound),
+								string(network.SecurityRuleDirectionOutbound),
+							}, true),
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkSecurityRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nsgId, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewNetworkSecurityRuleSetID(nsgId.SubscriptionId, nsgId.ResourceGroup, nsgId.Name, d.Get("name").(string))
+
+	start := int32(d.Get("priority_start").(int))
+	end := int32(d.Get("priority_end").(int))
+	if start > end {
+		return fmt.Errorf("`priority_start` (%d) must be less than or equal to `priority_end` (%d)", start, end)
+	}
+
+	rules, err := expandNetworkSecurityRuleSetRules(d.Get("rule").([]interface{}), id.RuleSetName)
+	if err != nil {
+		return fmt.Errorf("expanding `rule`: %+v", err)
+	}
+
+	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+
+	nsg, err := client.Get(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return fmt.Errorf("%s was not found", *nsgId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *nsgId, err)
+	}
+	if nsg.SecurityGroupPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *nsgId)
+	}
+
+	owned, others := splitNetworkSecurityRulesByRuleSet(nsg.SecurityGroupPropertiesFormat.SecurityRules, id.RuleSetName)
+	if d.IsNewResource() && len(owned) > 0 {
+		return tf.ImportAsExistsError("azurestack_network_security_rule_set", id.ID())
+	}
+
+	if err := allocateNetworkSecurityRuleSetPriorities(rules, others, start, end); err != nil {
+		return fmt.Errorf("allocating priorities for %s: %+v", id, err)
+	}
+
+	// rules managed outside of this Rule Set are sent back as-is, so that the whole change is a single PUT
+	updated := append(others, rules...)
+	nsg.SecurityGroupPropertiesFormat.SecurityRules = &updated
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, nsg)
+	if err != nil {
+		return fmt.Errorf("updating Security Rules for %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Security Rules for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return networkSecurityRuleSetRead(d, meta)
+}
+
+func networkSecurityRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityRuleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+	nsgId := parse.NewNetworkSecurityGroupID(id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName)
+
+	nsg, err := client.Get(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", nsgId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", nsgId, err)
+	}
+
+	var securityRules *[]network.SecurityRule
+	if props := nsg.SecurityGroupPropertiesFormat; props != nil {
+		securityRules = props.SecurityRules
+	}
+	owned, _ := splitNetworkSecurityRulesByRuleSet(securityRules, id.RuleSetName)
+	if len(owned) == 0 {
+		log.Printf("[DEBUG] no Security Rules were found for %s - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.RuleSetName)
+	d.Set("network_security_group_id", nsgId.ID())
+
+	if err := d.Set("rule", flattenNetworkSecurityRuleSetRules(owned, id.RuleSetName, d.Get("rule").([]interface{}))); err != nil {
+		return fmt.Errorf("setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func networkSecurityRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityRuleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+
+	nsg, err := client.Get(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Network Security Group %q (Resource Group %q): %+v", id.NetworkSecurityGroupName, id.ResourceGroup, err)
+	}
+	if nsg.SecurityGroupPropertiesFormat == nil {
+		return fmt.Errorf("retrieving Network Security Group %q (Resource Group %q): `properties` was nil", id.NetworkSecurityGroupName, id.ResourceGroup)
+	}
+
+	owned, others := splitNetworkSecurityRulesByRuleSet(nsg.SecurityGroupPropertiesFormat.SecurityRules, id.RuleSetName)
+	if len(owned) == 0 {
+		return nil
+	}
+	nsg.SecurityGroupPropertiesFormat.SecurityRules = &others
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, nsg)
+	if err != nil {
+		return fmt.Errorf("removing Security Rules for %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for removal of Security Rules for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkSecurityRuleSetRules(input []interface{}, ruleSetName string) ([]network.SecurityRule, error) {
+	rules := make([]network.SecurityRule, 0)
+	names := make(map[string]struct{})
+
+	for _, raw := range input {
+		rule := raw.(map[string]interface{})
+
+		if err := validateSecurityRule(rule); err != nil {
+			return nil, err
+		}
+
+		name := rule["name"].(string)
+		if _, exists := names[strings.ToLower(name)]; exists {
+			return nil, fmt.Errorf("the rule name %q is used more than once", name)
+		}
+		names[strings.ToLower(name)] = struct{}{}
+
+		properties := network.SecurityRulePropertiesFormat{
+			Access:    network.SecurityRuleAccess(rule["access"].(string)),
+			Direction: network.SecurityRuleDirection(rule["direction"].(string)),
+			Protocol:  network.SecurityRuleProtocol(rule["protocol"].(string)),
+		}
+
+		if v := rule["description"].(string); v != "" {
+			properties.Description = utils.String(v)
+		}
+		if v := rule["source_port_range"].(string); v != "" {
+			properties.SourcePortRange = utils.String(v)
+		}
+		if v := rule["destination_port_range"].(string); v != "" {
+			properties.DestinationPortRange = utils.String(v)
+		}
+		if v := rule["source_address_prefix"].(string); v != "" {
+			properties.SourceAddressPrefix = utils.String(v)
+		}
+		if v := rule["destination_address_prefix"].(string); v != "" {
+			properties.DestinationAddressPrefix = utils.String(v)
+		}
+		if v, ok := rule["source_port_ranges"].(*pluginsdk.Set); ok && v.Len() > 0 {
+			properties.SourcePortRanges = utils.ExpandStringSlice(v.List())
+		}
+		if v, ok := rule["destination_port_ranges"].(*pluginsdk.Set); ok && v.Len() > 0 {
+			properties.DestinationPortRanges = utils.ExpandStringSlice(v.List())
+		}
+		if v, ok := rule["source_address_prefixes"].(*pluginsdk.Set); ok && v.Len() > 0 {
+			properties.SourceAddressPrefixes = utils.ExpandStringSlice(v.List())
+		}
+		if v, ok := rule["destination_address_prefixes"].(*pluginsdk.Set); ok && v.Len() > 0 {
+			properties.DestinationAddressPrefixes = utils.ExpandStringSlice(v.List())
+		}
+
+		rules = append(rules, network.SecurityRule{
+			Name:                         utils.String(networkSecurityRuleSetRuleName(ruleSetName, name)),
+			SecurityRulePropertiesFormat: &properties,
+		})
+	}
+
+	return rules, nil
+}
+
+// flattenNetworkSecurityRuleSetRules flattens the rules owned by the Rule Set, keeping them in the same order
+// as they're currently defined so that only changes to the rules themselves show up as a diff - any owned
+// rules which aren't known about (e.g. when importing) are appended in priority order
+func flattenNetworkSecurityRuleSetRules(owned []network.SecurityRule, ruleSetName string, existing []interface{}) []interface{} {
+	order := make(map[string]int)
+	for i, raw := range existing {
+		if rule, ok := raw.(map[string]interface{}); ok {
+			order[strings.ToLower(rule["name"].(string))] = i
+		}
+	}
+
+	prefix := ruleSetName + networkSecurityRuleSetSeparator
+	flattened := flattenNetworkSecurityRules(&owned)
+	for _, rule := range flattened {
+		rule["name"] = strings.TrimPrefix(rule["name"].(string), prefix)
+	}
+
+	sort.SliceStable(flattened, func(i, j int) bool {
+		x, xKnown := order[strings.ToLower(flattened[i]["name"].(string))]
+		y, yKnown := order[strings.ToLower(flattened[j]["name"].(string))]
+		switch {
+		case xKnown && yKnown:
+			return x < y
+		case xKnown != yKnown:
+			return xKnown
+		}
+		return flattened[i]["priority"].(int) < flattened[j]["priority"].(int)
+	})
+
+	output := make([]interface{}, 0)
+	for _, rule := range flattened {
+		output = append(output, rule)
+	}
+	return output
+}
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type NetworkSecurityRuleSetResource struct{}
+
+func TestAccNetworkSecurityRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_security_rule_set", "test")
+	r := NetworkSecurityRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.priority").HasValue("200"),
+				check.That(data.ResourceName).Key("rule.1.priority").HasValue("201"),
+			),
+		},
+		data.ImportStep("priority_start", "priority_end"),
+	})
+}
+
+func TestAccNetworkSecurityRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_security_rule_set", "test")
+	r := NetworkSecurityRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_network_security_rule_set"),
+		},
+	})
+}
+
+func TestAccNetworkSecurityRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_security_rule_set", "test")
+	r := NetworkSecurityRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("priority_start", "priority_end"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("3"),
+				check.That(data.ResourceName).Key("rule.0.priority").HasValue("200"),
+				check.That(data.ResourceName).Key("rule.1.priority").HasValue("201"),
+				check.That(data.ResourceName).Key("rule.2.priority").HasValue("200"),
+			),
+		},
+		data.ImportStep("priority_start", "priority_end"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("priority_start", "priority_end"),
+	})
+}
+
+func TestAccNetworkSecurityRuleSet_outOfBandRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_security_rule_set", "test")
+	r := NetworkSecurityRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.outOfBandRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.priority").HasValue("201"),
+				check.That(data.ResourceName).Key("rule.1.priority").HasValue("202"),
+				check.That("azurestack_network_security_rule.test").ExistsInAzure(NetworkSecurityRuleResource{}),
+			),
+		},
+		data.ImportStep("priority_start", "priority_end"),
+	})
+}
+
+func (NetworkSecurityRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkSecurityRuleSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SecurityGroupClient.Get(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Network Security Group for %s: %+v", *id, err)
+	}
+
+	if resp.SecurityGroupPropertiesFormat == nil || resp.SecurityGroupPropertiesFormat.SecurityRules == nil {
+		return pointer.FromBool(false), nil
+	}
+
+	for _, rule := range *resp.SecurityGroupPropertiesFormat.SecurityRules {
+		if rule.Name != nil && strings.HasPrefix(*rule.Name, id.RuleSetName+".") {
+			return pointer.FromBool(true), nil
+		}
+	}
+
+	return pointer.FromBool(false), nil
+}
+
+func (NetworkSecurityRuleSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r NetworkSecurityRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_security_rule_set" "test" {
+  name                      = "web"
+  network_security_group_id = azurestack_network_security_group.test.id
+  priority_start            = 200
+  priority_end              = 299
+
+  rule {
+    name                       = "http"
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "80"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  rule {
+    name                       = "https"
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, r.template(data))
+}
+
+func (r NetworkSecurityRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_security_rule_set" "import" {
+  name                      = azurestack_network_security_rule_set.test.name
+  network_security_group_id = azurestack_network_security_rule_set.test.network_security_group_id
+  priority_start            = 300
+  priority_end              = 399
+
+  rule {
+    name                       = "http"
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "80"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, r.basic(data))
+}
+
+func (r NetworkSecurityRuleSetResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_security_rule_set" "test" {
+  name                      = "web"
+  network_security_group_id = azurestack_network_security_group.test.id
+  priority_start            = 200
+  priority_end              = 299
+
+  rule {
+    name                       = "https"
+    description                = "Allow HTTPS"
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefixes    = ["10.0.0.0/8", "192.168.0.0/16"]
+    destination_address_prefix = "*"
+  }
+
+  rule {
+    name                       = "deny-all"
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  rule {
+    name                       = "outbound-dns"
+    direction                  = "Outbound"
+    access                     = "Allow"
+    protocol                   = "Udp"
+    source_port_range          = "*"
+    destination_port_ranges    = ["53"]
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, r.template(data))
+}
+
+func (r NetworkSecurityRuleSetResource) outOfBandRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_security_rule" "test" {
+  name                        = "manual"
+  network_security_group_name = azurestack_network_security_group.test.name
+  resource_group_name         = azurestack_resource_group.test.name
+  priority                    = 200
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "22"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+}
+
+resource "azurestack_network_security_rule_set" "test" {
+  name                      = "web"
+  network_security_group_id = azurestack_network_security_group.test.id
+  priority_start            = 200
+  priority_end              = 299
+
+  rule {
+    name                       = "http"
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "80"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  rule {
+    name                       = "https"
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  depends_on = [azurestack_network_security_rule.test]
+}
+`, r.template(data))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+const (
+	networkUsageNameNetworkInterfaces = "NetworkInterfaces"
+	networkUsageNamePublicIPAddresses = "PublicIPAddresses"
+)
+
+func listNetworkUsages(ctx context.Context, client *network.UsagesClient, loc string) ([]network.Usage, error) {
+	results := make([]network.Usage, 0)
+
+	iterator, err := client.ListComplete(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("listing Network Usages for location %q: %+v", loc, err)
+	}
+
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("enumerating Network Usages for location %q: %+v", loc, err)
+		}
+	}
+
+	return results, nil
+}
+
+// networkUsageLimitCustomizeDiff returns a CustomizeDiffFunc which, when opted into via the `network` features block,
+// fails the plan when creating the resource would exceed the specified Network Usage counter in its location
+func networkUsageLimitCustomizeDiff(usageName string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !meta.(*clients.Client).Features.Network.CheckUsageLimitsDuringPlan {
+			return nil
+		}
+
+		// only new resources consume an additional unit of the quota
+		if d.Id() != "" {
+			return nil
+		}
+
+		// the location may not be known until apply time, in which case there's nothing we can check
+		rawLocation, ok := d.GetOk("location")
+		if !ok || !d.NewValueKnown("location") {
+			return nil
+		}
+		loc := location.Normalize(rawLocation.(string))
+
+		usages, err := listNetworkUsages(ctx, meta.(*clients.Client).Network.UsagesClient, loc)
+		if err != nil {
+			return err
+		}
+
+		return checkNetworkUsageLimit(usages, usageName, loc)
+	}
+}
+
+func checkNetworkUsageLimit(usages []network.Usage, usageName string, loc string) error {
+	for _, usage := range usages {
+		if usage.Name == nil || usage.Name.Value == nil || !strings.EqualFold(*usage.Name.Value, usageName) {
+			continue
+		}
+
+		if usage.CurrentValue == nil || usage.Limit == nil {
+			return nil
+		}
+
+		// a negative limit means the counter is unlimited
+		if *usage.Limit < 0 {
+			return nil
+		}
+
+		if *usage.CurrentValue+1 > *usage.Limit {
+			return fmt.Errorf("creating this resource would exceed the %q usage limit in location %q (currently using %d of %d)", usageName, loc, *usage.CurrentValue, *usage.Limit)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Network Usage %q was not found in location %q - skipping the usage limit check", usageName, loc)
+	return nil
+}
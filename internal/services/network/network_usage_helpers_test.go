@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func TestCheckNetworkUsageLimit(t *testing.T) {
+	usages := []network.Usage{
+		{
+			Name:         &network.UsageName{Value: utils.String("PublicIPAddresses")},
+			CurrentValue: utils.Int64(9),
+			Limit:        utils.Int64(10),
+		},
+		{
+			Name:         &network.UsageName{Value: utils.String("NetworkInterfaces")},
+			CurrentValue: utils.Int64(350),
+			Limit:        utils.Int64(350),
+		},
+		{
+			Name:         &network.UsageName{Value: utils.String("VirtualNetworks")},
+			CurrentValue: utils.Int64(12),
+			Limit:        utils.Int64(-1),
+		},
+	}
+
+	testData := []struct {
+		UsageName string
+		Error     bool
+	}{
+		{
+			UsageName: "PublicIPAddresses",
+			Error:     false,
+		},
+		{
+			UsageName: "networkinterfaces",
+			Error:     true,
+		},
+		{
+			UsageName: "VirtualNetworks",
+			Error:     false,
+		},
+		{
+			UsageName: "LoadBalancers",
+			Error:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.UsageName)
+
+		err := checkNetworkUsageLimit(usages, v.UsageName, "local")
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
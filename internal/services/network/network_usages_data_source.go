@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func networkUsagesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: networkUsagesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"usages": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"localized_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"current_value": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"limit": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkUsagesDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.UsagesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+
+	usages, err := listNetworkUsages(ctx, client, loc)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/locations/%s/usages", subscriptionId, loc))
+	d.Set("location", loc)
+
+	if err := d.Set("usages", flattenNetworkUsages(usages)); err != nil {
+		return fmt.Errorf("setting `usages`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkUsages(input []network.Usage) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, usage := range input {
+		name := ""
+		localizedName := ""
+		if usage.Name != nil {
+			if usage.Name.Value != nil {
+				name = *usage.Name.Value
+			}
+			if usage.Name.LocalizedValue != nil {
+				localizedName = *usage.Name.LocalizedValue
+			}
+		}
+
+		currentValue := 0
+		if usage.CurrentValue != nil {
+			currentValue = int(*usage.CurrentValue)
+		}
+
+		limit := 0
+		if usage.Limit != nil {
+			limit = int(*usage.Limit)
+		}
+
+		unit := ""
+		if usage.Unit != nil {
+			unit = *usage.Unit
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"localized_name": localizedName,
+			"current_value":  currentValue,
+			"limit":          limit,
+			"unit":           unit,
+		})
+	}
+
+	return results
+}
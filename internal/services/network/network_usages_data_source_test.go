@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type NetworkUsagesDataSource struct{}
+
+func TestAccNetworkUsagesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_network_usages", "test")
+	r := NetworkUsagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("usages.#").Exists(),
+				check.That(data.ResourceName).Key("usages.0.name").Exists(),
+				check.That(data.ResourceName).Key("usages.0.limit").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkUsagesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_network_usages" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
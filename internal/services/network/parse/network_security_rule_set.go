@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NetworkSecurityRuleSetId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	RuleSetName              string
+}
+
+func NewNetworkSecurityRuleSetID(subscriptionId, resourceGroup, networkSecurityGroupName, ruleSetName string) NetworkSecurityRuleSetId {
+	return NetworkSecurityRuleSetId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		NetworkSecurityGroupName: networkSecurityGroupName,
+		RuleSetName:              ruleSetName,
+	}
+}
+
+func (id NetworkSecurityRuleSetId) String() string {
+	segments := []string{
+		fmt.Sprintf("Rule Set Name %q", id.RuleSetName),
+		fmt.Sprintf("Network Security Group Name %q", id.NetworkSecurityGroupName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Security Rule Set", segmentsStr)
+}
+
+func (id NetworkSecurityRuleSetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s/ruleSets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName, id.RuleSetName)
+}
+
+// NetworkSecurityRuleSetID parses a NetworkSecurityRuleSet ID into an NetworkSecurityRuleSetId struct
+func NetworkSecurityRuleSetID(input string) (*NetworkSecurityRuleSetId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkSecurityRuleSetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkSecurityGroupName, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleSetName, err = id.PopSegment("ruleSets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RouteFilterRuleId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	RouteFilterName string
+	Name            string
+}
+
+func NewRouteFilterRuleID(subscriptionId, resourceGroup, routeFilterName, name string) RouteFilterRuleId {
+	return RouteFilterRuleId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		RouteFilterName: routeFilterName,
+		Name:            name,
+	}
+}
+
+func (id RouteFilterRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Route Filter Name %q", id.RouteFilterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Route Filter Rule", segmentsStr)
+}
+
+func (id RouteFilterRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeFilters/%s/routeFilterRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RouteFilterName, id.Name)
+}
+
+// RouteFilterRuleID parses a RouteFilterRule ID into an RouteFilterRuleId struct
+func RouteFilterRuleID(input string) (*RouteFilterRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RouteFilterRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RouteFilterName, err = id.PopSegment("routeFilters"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("routeFilterRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
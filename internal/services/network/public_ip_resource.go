@@ -89,6 +89,7 @@ func publicIp() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(network.IPv4),
+					string(network.IPv6),
 				}, true),
 			},
 
@@ -133,6 +134,8 @@ func publicIp() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkUsageLimitCustomizeDiff(networkUsageNamePublicIPAddresses)),
 	}
 }
 
@@ -175,6 +178,10 @@ func publicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if strings.EqualFold(string(ipVersion), string(network.IPv6)) && !strings.EqualFold(ipAllocationMethod, string(network.Dynamic)) {
+		return fmt.Errorf("Dynamic IP allocation must be used when creating IPv6 public IP addresses.")
+	}
+
 	publicIp := network.PublicIPAddress{
 		Name:     pointer.FromString(id.Name),
 		Location: &location,
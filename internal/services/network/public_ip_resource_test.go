@@ -38,6 +38,22 @@ func TestAccPublicIpStatic_basic(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpStatic_checkUsageLimitsDuringPlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.static_checkUsageLimitsDuringPlan(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_address").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPublicIpStatic_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_public_ip", "test")
 	r := PublicIPResource{}
@@ -111,6 +127,22 @@ func TestAccPublicIpStatic_basic_withIPv4(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpDynamic_basic_withIPv6(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dynamic_basic_withIPv6(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_version").HasValue("IPv6"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPublicIpStatic_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_public_ip", "test")
 	r := PublicIPResource{}
@@ -288,6 +320,30 @@ resource "azurestack_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (PublicIPResource) static_checkUsageLimitsDuringPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    network {
+      check_usage_limits_during_plan = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r PublicIPResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -343,6 +399,27 @@ resource "azurestack_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, ipVersion)
 }
 
+func (PublicIPResource) dynamic_basic_withIPv6(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+  ip_version          = "IPv6"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (PublicIPResource) update(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -24,16 +24,20 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_network_interface":                  networkInterfaceDataSource(),
-		"azurestack_public_ip":                          publicIPDataSource(),
-		"azurestack_public_ips":                         publicIPsDataSource(),
-		"azurestack_route_table":                        routeTableDataSource(),
-		"azurestack_subnet":                             subnetDataSource(),
-		"azurestack_virtual_network":                    virtualNetworkDataSource(),
-		"azurestack_network_security_group":             networkSecurityGroupDataSource(),
-		"azurestack_virtual_network_gateway":            virtualNetworkGatewayDataSource(),
-		"azurestack_virtual_network_gateway_connection": virtualNetworkGatewayConnectionDataSource(),
-		"azurestack_local_network_gateway":              localNetworkGatewayDataSource(),
+		"azurestack_network_interface":                          networkInterfaceDataSource(),
+		"azurestack_public_ip":                                  publicIPDataSource(),
+		"azurestack_public_ips":                                 publicIPsDataSource(),
+		"azurestack_route_table":                                routeTableDataSource(),
+		"azurestack_subnet":                                     subnetDataSource(),
+		"azurestack_virtual_network":                            virtualNetworkDataSource(),
+		"azurestack_network_security_group":                     networkSecurityGroupDataSource(),
+		"azurestack_virtual_network_gateway":                    virtualNetworkGatewayDataSource(),
+		"azurestack_virtual_network_gateway_connection":         virtualNetworkGatewayConnectionDataSource(),
+		"azurestack_local_network_gateway":                      localNetworkGatewayDataSource(),
+		"azurestack_virtual_network_ip_availability":            virtualNetworkIPAvailabilityDataSource(),
+		"azurestack_network_usages":                             networkUsagesDataSource(),
+		"azurestack_virtual_network_gateway_vpn_client_package": virtualNetworkGatewayVpnClientPackageDataSource(),
+		"azurestack_virtual_network_gateway_bgp_status":         virtualNetworkGatewayBgpStatusDataSource(),
 	}
 }
 
@@ -44,11 +48,15 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurestack_public_ip":                                          publicIp(),
 		"azurestack_route_table":                                        routeTable(),
 		"azurestack_route":                                              resourceRoute(),
+		"azurestack_route_filter":                                       routeFilter(),
+		"azurestack_route_filter_rule":                                  routeFilterRule(),
 		"azurestack_subnet":                                             subnet(),
 		"azurestack_virtual_network":                                    virtualNetwork(),
 		"azurestack_network_security_group":                             networkSecurityGroup(),
 		"azurestack_network_security_rule":                              networkSecurityRule(),
+		"azurestack_network_security_rule_set":                          networkSecurityRuleSet(),
 		"azurestack_virtual_network_gateway_connection":                 virtualNetworkGatewayConnection(),
+		"azurestack_virtual_network_gateway_connection_shared_key":      virtualNetworkGatewayConnectionSharedKey(),
 		"azurestack_virtual_network_gateway":                            virtualNetworkGateway(),
 		"azurestack_local_network_gateway":                              localNetworkGateway(),
 		"azurestack_virtual_network_peering":                            virtualNetworkPeering(),
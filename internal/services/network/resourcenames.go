@@ -6,6 +6,7 @@ package network
 var (
 	networkInterfaceResourceName     = "azurestack_network_interface"
 	networkSecurityGroupResourceName = "azurestack_network_security_group"
+	routeFilterResourceName          = "azurestack_route_filter"
 	routeTableResourceName           = "azurestack_route_table"
 	SubnetResourceName               = "azurestack_subnet"
 	VirtualNetworkResourceName       = "azurestack_virtual_network"
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// routeFilterRuleTypeCommunity is the only rule type supported by the API
+const routeFilterRuleTypeCommunity = "Community"
+
+func routeFilter() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: routeFilterCreateUpdate,
+		Read:   routeFilterRead,
+		Update: routeFilterCreateUpdate,
+		Delete: routeFilterDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RouteFilterID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RouteFilterName,
+			},
+
+			"location": commonschema.Location(),
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"rule": {
+				Type:       pluginsdk.TypeList,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.RouteFilterRuleName,
+						},
+
+						"access": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Allow),
+							}, false),
+						},
+
+						"rule_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								routeFilterRuleTypeCommunity,
+							}, false),
+						},
+
+						"communities": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func routeFilterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteFiltersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for azurestack Route Filter creation.")
+
+	id := parse.NewRouteFilterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	location := location.Normalize(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurestack_route_filter", id.ID())
+		}
+	}
+
+	locks.ByName(id.Name, routeFilterResourceName)
+	defer locks.UnlockByName(id.Name, routeFilterResourceName)
+
+	routeFilter := network.RouteFilter{
+		Name:     pointer.FromString(id.Name),
+		Location: pointer.FromString(location),
+		RouteFilterPropertiesFormat: &network.RouteFilterPropertiesFormat{
+			Rules: expandRouteFilterRules(d.Get("rule").([]interface{})),
+		},
+		Tags: tags.Expand(t),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, routeFilter)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return routeFilterRead(d, meta)
+}
+
+func routeFilterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteFiltersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.RouteFilterPropertiesFormat; props != nil {
+		if err := d.Set("rule", flattenRouteFilterRules(props.Rules)); err != nil {
+			return fmt.Errorf("setting `rule`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func routeFilterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteFiltersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, routeFilterResourceName)
+	defer locks.UnlockByName(id.Name, routeFilterResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.WasNotFound(future.Response()) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandRouteFilterRules(input []interface{}) *[]network.RouteFilterRule {
+	rules := make([]network.RouteFilterRule, 0, len(input))
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, network.RouteFilterRule{
+			Name: pointer.FromString(v["name"].(string)),
+			RouteFilterRulePropertiesFormat: &network.RouteFilterRulePropertiesFormat{
+				Access:              network.Access(v["access"].(string)),
+				RouteFilterRuleType: pointer.FromString(v["rule_type"].(string)),
+				Communities:         utils.ExpandStringSlice(v["communities"].([]interface{})),
+			},
+		})
+	}
+
+	return &rules
+}
+
+func flattenRouteFilterRules(input *[]network.RouteFilterRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		access := ""
+		ruleType := ""
+		communities := make([]interface{}, 0)
+		if props := rule.RouteFilterRulePropertiesFormat; props != nil {
+			access = string(props.Access)
+			if props.RouteFilterRuleType != nil {
+				ruleType = *props.RouteFilterRuleType
+			}
+			communities = utils.FlattenStringSlice(props.Communities)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":        name,
+			"access":      access,
+			"rule_type":   ruleType,
+			"communities": communities,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func routeFilterRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: routeFilterRuleCreateUpdate,
+		Read:   routeFilterRuleRead,
+		Update: routeFilterRuleCreateUpdate,
+		Delete: routeFilterRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RouteFilterRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RouteFilterRuleName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"route_filter_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RouteFilterName,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Allow),
+				}, false),
+			},
+
+			"rule_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					routeFilterRuleTypeCommunity,
+				}, false),
+			},
+
+			"communities": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func routeFilterRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteFilterRulesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewRouteFilterRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("route_filter_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.RouteFilterName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurestack_route_filter_rule", id.ID())
+		}
+	}
+
+	locks.ByName(id.RouteFilterName, routeFilterResourceName)
+	defer locks.UnlockByName(id.RouteFilterName, routeFilterResourceName)
+
+	rule := network.RouteFilterRule{
+		Name: pointer.FromString(id.Name),
+		RouteFilterRulePropertiesFormat: &network.RouteFilterRulePropertiesFormat{
+			Access:              network.Access(d.Get("access").(string)),
+			RouteFilterRuleType: pointer.FromString(d.Get("rule_type").(string)),
+			Communities:         utils.ExpandStringSlice(d.Get("communities").([]interface{})),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.RouteFilterName, id.Name, rule)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return routeFilterRuleRead(d, meta)
+}
+
+func routeFilterRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteFilterRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteFilterRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.RouteFilterName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("route_filter_name", id.RouteFilterName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.RouteFilterRulePropertiesFormat; props != nil {
+		d.Set("access", string(props.Access))
+		d.Set("rule_type", props.RouteFilterRuleType)
+		if err := d.Set("communities", utils.FlattenStringSlice(props.Communities)); err != nil {
+			return fmt.Errorf("setting `communities`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func routeFilterRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteFilterRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteFilterRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.RouteFilterName, routeFilterResourceName)
+	defer locks.UnlockByName(id.RouteFilterName, routeFilterResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteFilterName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
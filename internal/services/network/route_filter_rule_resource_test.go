@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type RouteFilterRuleResource struct{}
+
+func TestAccRouteFilterRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_route_filter_rule", "test")
+	r := RouteFilterRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRouteFilterRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_route_filter_rule", "test")
+	r := RouteFilterRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_route_filter_rule"),
+		},
+	})
+}
+
+func TestAccRouteFilterRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_route_filter_rule", "test")
+	r := RouteFilterRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("communities.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("communities.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t RouteFilterRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RouteFilterRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.RouteFilterRulesClient.Get(ctx, id.ResourceGroup, id.RouteFilterName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (r RouteFilterRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_route_filter_rule" "test" {
+  name                = "acctestrule%d"
+  resource_group_name = azurestack_resource_group.test.name
+  route_filter_name   = azurestack_route_filter.test.name
+  access              = "Allow"
+  rule_type           = "Community"
+  communities         = ["12076:52005"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RouteFilterRuleResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_route_filter_rule" "test" {
+  name                = "acctestrule%d"
+  resource_group_name = azurestack_resource_group.test.name
+  route_filter_name   = azurestack_route_filter.test.name
+  access              = "Allow"
+  rule_type           = "Community"
+  communities         = ["12076:52005", "12076:52006"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RouteFilterRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_route_filter_rule" "import" {
+  name                = azurestack_route_filter_rule.test.name
+  resource_group_name = azurestack_route_filter_rule.test.resource_group_name
+  route_filter_name   = azurestack_route_filter_rule.test.route_filter_name
+  access              = azurestack_route_filter_rule.test.access
+  rule_type           = azurestack_route_filter_rule.test.rule_type
+  communities         = azurestack_route_filter_rule.test.communities
+}
+`, r.basic(data))
+}
+
+func (RouteFilterRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_route_filter" "test" {
+  name                = "acctestrf%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -45,6 +45,14 @@ func subnetDataSource() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"network_security_group_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -79,7 +87,11 @@ func subnetDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", id.ResourceGroup)
 
 	if props := resp.SubnetPropertiesFormat; props != nil {
-		d.Set("address_prefix", props.AddressPrefix)
+		addressPrefix, addressPrefixes := flattenSubnetAddressPrefixes(props)
+		d.Set("address_prefix", addressPrefix)
+		if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+			return fmt.Errorf("setting `address_prefixes`: %+v", err)
+		}
 
 		networkSecurityGroupId := ""
 		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
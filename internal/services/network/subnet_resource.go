@@ -40,6 +40,8 @@ func subnet() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(subnetAddressPrefixesCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -57,8 +59,22 @@ func subnet() *pluginsdk.Resource {
 
 			"address_prefix": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+			},
+
+			"address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
 			},
 
 			/*
@@ -107,6 +123,9 @@ func subnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		addressPrefix := value.(string)
 		properties.AddressPrefix = &addressPrefix
 	}
+	if value, ok := d.GetOk("address_prefixes"); ok {
+		properties.AddressPrefixes = utils.ExpandStringSlice(value.([]interface{}))
+	}
 
 	subnet := network.Subnet{
 		Name:                   pointer.FromString(id.Name),
@@ -181,8 +200,15 @@ func subnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	props := *existing.SubnetPropertiesFormat
 
-	if d.HasChange("address_prefix") {
-		props.AddressPrefix = pointer.FromString(d.Get("address_prefix").(string))
+	// only one of `address_prefix` and `address_prefixes` can be sent, the API returns whichever was last used
+	if d.HasChanges("address_prefix", "address_prefixes") {
+		if d.GetRawConfig().GetAttr("address_prefixes").IsNull() {
+			props.AddressPrefix = pointer.FromString(d.Get("address_prefix").(string))
+			props.AddressPrefixes = nil
+		} else {
+			props.AddressPrefix = nil
+			props.AddressPrefixes = utils.ExpandStringSlice(d.Get("address_prefixes").([]interface{}))
+		}
 	}
 
 	subnet := network.Subnet{
@@ -251,7 +277,11 @@ func subnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", id.ResourceGroup)
 
 	if props := resp.SubnetPropertiesFormat; props != nil {
-		d.Set("address_prefix", props.AddressPrefix)
+		addressPrefix, addressPrefixes := flattenSubnetAddressPrefixes(props)
+		d.Set("address_prefix", addressPrefix)
+		if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+			return fmt.Errorf("setting `address_prefixes`: %+v", err)
+		}
 	}
 
 	return nil
@@ -297,3 +327,43 @@ func SubnetProvisioningStateRefreshFunc(ctx context.Context, client *network.Sub
 		return res, *res.ProvisioningState, nil
 	}
 }
+
+// subnetAddressPrefixesCustomizeDiff ensures there's a diff when switching from `address_prefixes` back to `address_prefix`,
+// since both fields are Computed removing `address_prefixes` from the config otherwise leaves the plan empty
+func subnetAddressPrefixesCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.GetRawConfig().GetAttr("address_prefixes").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("address_prefix") {
+		return d.SetNewComputed("address_prefixes")
+	}
+
+	addressPrefix := d.Get("address_prefix").(string)
+	addressPrefixes := d.Get("address_prefixes").([]interface{})
+	if len(addressPrefixes) == 1 && addressPrefixes[0].(string) == addressPrefix {
+		return nil
+	}
+
+	return d.SetNew("address_prefixes", []interface{}{addressPrefix})
+}
+
+// flattenSubnetAddressPrefixes returns both the singular and plural forms of the Address Prefixes for the Subnet,
+// since the API only returns the field which was specified - the singular form being the first prefix
+func flattenSubnetAddressPrefixes(props *network.SubnetPropertiesFormat) (string, []interface{}) {
+	addressPrefixes := make([]interface{}, 0)
+	if props.AddressPrefixes != nil && len(*props.AddressPrefixes) > 0 {
+		addressPrefixes = utils.FlattenStringSlice(props.AddressPrefixes)
+	} else if props.AddressPrefix != nil {
+		addressPrefixes = append(addressPrefixes, *props.AddressPrefix)
+	}
+
+	addressPrefix := ""
+	if props.AddressPrefix != nil {
+		addressPrefix = *props.AddressPrefix
+	} else if len(addressPrefixes) > 0 {
+		addressPrefix = addressPrefixes[0].(string)
+	}
+
+	return addressPrefix, addressPrefixes
+}
@@ -85,6 +85,31 @@ func TestAccSubnet_updateAddressPrefix(t *testing.T) {
 	})
 }
 
+func TestAccSubnet_dualStack(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dualStack(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dualStackRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefix").HasValue("10.0.2.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t SubnetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubnetID(state.ID)
 	if err != nil {
@@ -163,6 +188,52 @@ resource "azurestack_subnet" "test" {
 `, r.template(data))
 }
 
+func (r SubnetResource) dualStack(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24", "ace:cab:deca:deed::/64"]
+}
+`, r.dualStackTemplate(data))
+}
+
+func (r SubnetResource) dualStackRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+`, r.dualStackTemplate(data))
+}
+
+func (SubnetResource) dualStackTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16", "ace:cab:deca::/48"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (SubnetResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// NetworkSecurityRuleSetName validates the name of a Network Security Rule Set, which is used as the prefix
+// for the names of the Security Rules within it - as such it can't contain a period, since that's the separator
+func NetworkSecurityRuleSetName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,39}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q should be between 1 and 40 characters, start with an alphanumeric and can contain alphanumerics, underscores and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var routeFilterNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`)
+
+func RouteFilterName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateRouteFilterName(v, k)
+}
+
+func RouteFilterRuleName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateRouteFilterName(v, k)
+}
+
+// validateRouteFilterName validates the name of a Route Filter or a Route Filter Rule, which share the same rules
+func validateRouteFilterName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !routeFilterNameRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q should be between 1 and 80 characters, start with an alphanumeric, end with an alphanumeric or underscore and can contain alphanumerics, underscores, periods, and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestRouteFilterName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// single character
+			input:    "a",
+			expected: true,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// may contain alphanumerics, dots, dashes and underscores
+			input:    "hello_world7.goodbye-world4",
+			expected: true,
+		},
+		{
+			// 80 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzab",
+			expected: true,
+		},
+		{
+			// 81 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabc",
+			expected: false,
+		},
+		{
+			// must begin with an alphanumeric
+			input:    "_hello",
+			expected: false,
+		},
+		{
+			// can't end with a dash
+			input:    "a-",
+			expected: false,
+		},
+		{
+			// can't end with a period
+			input:    "a.",
+			expected: false,
+		},
+		{
+			// can end with an underscore
+			input:    "a_",
+			expected: true,
+		},
+		{
+			// can't contain an exclamation mark
+			input:    "hello!",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		for name, validateFunc := range map[string]func(interface{}, string) ([]string, []error){
+			"RouteFilterName":     RouteFilterName,
+			"RouteFilterRuleName": RouteFilterRuleName,
+		} {
+			_, errors := validateFunc(v.input, "name")
+			actual := len(errors) == 0
+			if v.expected != actual {
+				t.Fatalf("%s: expected %t but got %t", name, v.expected, actual)
+			}
+		}
+	}
+}
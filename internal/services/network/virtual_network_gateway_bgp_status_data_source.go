@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func virtualNetworkGatewayBgpStatusDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: virtualNetworkGatewayBgpStatusDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"peer": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"bgp_peers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"local_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"neighbor": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"asn": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"connected_duration": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"routes_received": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"messages_sent": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"messages_received": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"learned_routes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: gatewayRouteSchema(),
+				},
+			},
+
+			"advertised_routes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"peer": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"routes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: gatewayRouteSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func gatewayRouteSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"local_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"network": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_hop": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source_peer": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"origin": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"as_path": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"weight": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func virtualNetworkGatewayBgpStatusDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	peer := d.Get("peer").(string)
+
+	peers, err := getVirtualNetworkGatewayBgpPeerStatus(ctx, client, *id, peer)
+	if err != nil {
+		return err
+	}
+
+	learnedRoutes, err := getVirtualNetworkGatewayLearnedRoutes(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	// the advertised routes are per-peer, so retrieve them for each of the peers we know about
+	advertisedRoutes := make([]interface{}, 0)
+	for _, p := range peers {
+		if p.Neighbor == nil || *p.Neighbor == "" {
+			continue
+		}
+
+		routes, err := getVirtualNetworkGatewayAdvertisedRoutes(ctx, client, *id, *p.Neighbor)
+		if err != nil {
+			return err
+		}
+
+		advertisedRoutes = append(advertisedRoutes, map[string]interface{}{
+			"peer":   *p.Neighbor,
+			"routes": flattenGatewayRoutes(routes),
+		})
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("bgp_peers", flattenBgpPeerStatuses(peers)); err != nil {
+		return fmt.Errorf("setting `bgp_peers`: %+v", err)
+	}
+	if err := d.Set("learned_routes", flattenGatewayRoutes(learnedRoutes)); err != nil {
+		return fmt.Errorf("setting `learned_routes`: %+v", err)
+	}
+	if err := d.Set("advertised_routes", advertisedRoutes); err != nil {
+		return fmt.Errorf("setting `advertised_routes`: %+v", err)
+	}
+
+	return nil
+}
+
+func getVirtualNetworkGatewayBgpPeerStatus(ctx context.Context, client *network.VirtualNetworkGatewaysClient, id parse.VirtualNetworkGatewayId, peer string) ([]network.BgpPeerStatus, error) {
+	future, err := client.GetBgpPeerStatus(ctx, id.ResourceGroup, id.Name, peer)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving BGP Peer Status for %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for BGP Peer Status for %s: %+v", id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving result of BGP Peer Status for %s: %+v", id, err)
+	}
+
+	if result.Value == nil {
+		return []network.BgpPeerStatus{}, nil
+	}
+	return *result.Value, nil
+}
+
+func getVirtualNetworkGatewayLearnedRoutes(ctx context.Context, client *network.VirtualNetworkGatewaysClient, id parse.VirtualNetworkGatewayId) ([]network.GatewayRoute, error) {
+	future, err := client.GetLearnedRoutes(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Learned Routes for %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for Learned Routes for %s: %+v", id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving result of Learned Routes for %s: %+v", id, err)
+	}
+
+	if result.Value == nil {
+		return []network.GatewayRoute{}, nil
+	}
+	return *result.Value, nil
+}
+
+func getVirtualNetworkGatewayAdvertisedRoutes(ctx context.Context, client *network.VirtualNetworkGatewaysClient, id parse.VirtualNetworkGatewayId, peer string) ([]network.GatewayRoute, error) {
+	future, err := client.GetAdvertisedRoutes(ctx, id.ResourceGroup, id.Name, peer)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Advertised Routes to peer %q for %s: %+v", peer, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for Advertised Routes to peer %q for %s: %+v", peer, id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving result of Advertised Routes to peer %q for %s: %+v", peer, id, err)
+	}
+
+	if result.Value == nil {
+		return []network.GatewayRoute{}, nil
+	}
+	return *result.Value, nil
+}
+
+func flattenBgpPeerStatuses(input []network.BgpPeerStatus) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		localAddress := ""
+		if v.LocalAddress != nil {
+			localAddress = *v.LocalAddress
+		}
+
+		neighbor := ""
+		if v.Neighbor != nil {
+			neighbor = *v.Neighbor
+		}
+
+		asn := 0
+		if v.Asn != nil {
+			asn = int(*v.Asn)
+		}
+
+		connectedDuration := ""
+		if v.ConnectedDuration != nil {
+			connectedDuration = *v.ConnectedDuration
+		}
+
+		routesReceived := 0
+		if v.RoutesReceived != nil {
+			routesReceived = int(*v.RoutesReceived)
+		}
+
+		messagesSent := 0
+		if v.MessagesSent != nil {
+			messagesSent = int(*v.MessagesSent)
+		}
+
+		messagesReceived := 0
+		if v.MessagesReceived != nil {
+			messagesReceived = int(*v.MessagesReceived)
+		}
+
+		results = append(results, map[string]interface{}{
+			"local_address":      localAddress,
+			"neighbor":           neighbor,
+			"asn":                asn,
+			"state":              string(v.State),
+			"connected_duration": connectedDuration,
+			"routes_received":    routesReceived,
+			"messages_sent":      messagesSent,
+			"messages_received":  messagesReceived,
+		})
+	}
+
+	return results
+}
+
+func flattenGatewayRoutes(input []network.GatewayRoute) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		localAddress := ""
+		if v.LocalAddress != nil {
+			localAddress = *v.LocalAddress
+		}
+
+		networkPrefix := ""
+		if v.NetworkProperty != nil {
+			networkPrefix = *v.NetworkProperty
+		}
+
+		nextHop := ""
+		if v.NextHop != nil {
+			nextHop = *v.NextHop
+		}
+
+		sourcePeer := ""
+		if v.SourcePeer != nil {
+			sourcePeer = *v.SourcePeer
+		}
+
+		origin := ""
+		if v.Origin != nil {
+			origin = *v.Origin
+		}
+
+		asPath := ""
+		if v.AsPath != nil {
+			asPath = *v.AsPath
+		}
+
+		weight := 0
+		if v.Weight != nil {
+			weight = int(*v.Weight)
+		}
+
+		results = append(results, map[string]interface{}{
+			"local_address": localAddress,
+			"network":       networkPrefix,
+			"next_hop":      nextHop,
+			"source_peer":   sourcePeer,
+			"origin":        origin,
+			"as_path":       asPath,
+			"weight":        weight,
+		})
+	}
+
+	return results
+}
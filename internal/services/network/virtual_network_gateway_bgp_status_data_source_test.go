@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type VirtualNetworkGatewayBgpStatusDataSource struct{}
+
+func TestAccVirtualNetworkGatewayBgpStatusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_virtual_network_gateway_bgp_status", "test")
+	r := VirtualNetworkGatewayBgpStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("bgp_peers.#").Exists(),
+				check.That(data.ResourceName).Key("learned_routes.#").Exists(),
+				check.That(data.ResourceName).Key("advertised_routes.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayBgpStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurestack_virtual_network_gateway" "test" {
+  name                = "acctestvng-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  type       = "Vpn"
+  vpn_type   = "RouteBased"
+  sku        = "Standard"
+  enable_bgp = true
+
+  ip_configuration {
+    public_ip_address_id          = azurestack_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurestack_subnet.test.id
+  }
+
+  bgp_settings {
+    asn = 65010
+  }
+}
+
+data "azurestack_virtual_network_gateway_bgp_status" "test" {
+  virtual_network_gateway_id = azurestack_virtual_network_gateway.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
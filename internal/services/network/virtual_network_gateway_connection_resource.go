@@ -122,6 +122,7 @@ func virtualNetworkGatewayConnection() *pluginsdk.Resource {
 			"shared_key": {
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
+				Computed:  true,
 				Sensitive: true,
 			},
 
@@ -291,7 +292,7 @@ func virtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("waiting for completion of %s: %+v", id, err)
 	}
 
-	if properties.SharedKey != nil && !d.IsNewResource() {
+	if properties.SharedKey != nil && !d.IsNewResource() && d.HasChange("shared_key") {
 		future, err := client.SetSharedKey(ctx, id.ResourceGroup, id.ConnectionName, network.ConnectionSharedKey{
 			Value: properties.SharedKey,
 		})
@@ -479,7 +480,9 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 		props.RoutingWeight = &routingWeight
 	}
 
-	if v, ok := d.GetOk("shared_key"); ok {
+	// `shared_key` is Computed, so only send it when it's been set/changed in the config - otherwise
+	// the key from the state would revert any rotation done via `azurestack_virtual_network_gateway_connection_shared_key`
+	if v, ok := d.GetOk("shared_key"); ok && (d.IsNewResource() || d.HasChange("shared_key")) {
 		props.SharedKey = pointer.FromString(v.(string))
 	}
 
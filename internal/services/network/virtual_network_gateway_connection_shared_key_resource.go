@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func virtualNetworkGatewayConnectionSharedKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: virtualNetworkGatewayConnectionSharedKeyCreateUpdate,
+		Read:   virtualNetworkGatewayConnectionSharedKeyRead,
+		Update: virtualNetworkGatewayConnectionSharedKeyCreateUpdate,
+		Delete: virtualNetworkGatewayConnectionSharedKeyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkGatewayConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_connection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkGatewayConnectionID,
+			},
+
+			"shared_key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				ExactlyOneOf: []string{"shared_key", "key_length"},
+			},
+
+			// when `key_length` is specified the key is generated by the platform using
+			// ResetSharedKey, changing the length generates a new key
+			"key_length": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 128),
+				ExactlyOneOf: []string{"shared_key", "key_length"},
+			},
+		},
+	}
+}
+
+func virtualNetworkGatewayConnectionSharedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkGatewayConnectionID(d.Get("virtual_network_gateway_connection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if v, ok := d.GetOk("key_length"); ok {
+		if d.IsNewResource() || d.HasChange("key_length") {
+			future, err := client.ResetSharedKey(ctx, id.ResourceGroup, id.ConnectionName, network.ConnectionResetSharedKey{
+				KeyLength: utils.Int32(int32(v.(int))),
+			})
+			if err != nil {
+				return fmt.Errorf("resetting Shared Key for %s: %+v", *id, err)
+			}
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for reset of Shared Key for %s: %+v", *id, err)
+			}
+		}
+	} else if d.IsNewResource() || d.HasChange("shared_key") {
+		future, err := client.SetSharedKey(ctx, id.ResourceGroup, id.ConnectionName, network.ConnectionSharedKey{
+			Value: pointer.FromString(d.Get("shared_key").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("updating Shared Key for %s: %+v", *id, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of Shared Key for %s: %+v", *id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return virtualNetworkGatewayConnectionSharedKeyRead(d, meta)
+}
+
+func virtualNetworkGatewayConnectionSharedKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkGatewayConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSharedKey(ctx, id.ResourceGroup, id.ConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing Shared Key from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Shared Key for %s: %+v", *id, err)
+	}
+
+	d.Set("virtual_network_gateway_connection_id", id.ID())
+	d.Set("shared_key", resp.Value)
+
+	return nil
+}
+
+func virtualNetworkGatewayConnectionSharedKeyDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// a connection always has a shared key, so there's nothing to remove in Azure - the key
+	// in use is left in place until it's changed by something else
+	id, err := parse.NetworkGatewayConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] removing the Shared Key for %s from state only", *id)
+
+	return nil
+}
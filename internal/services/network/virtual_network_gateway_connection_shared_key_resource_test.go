@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type VirtualNetworkGatewayConnectionSharedKeyResource struct{}
+
+func TestAccVirtualNetworkGatewayConnectionSharedKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway_connection_shared_key", "test")
+	r := VirtualNetworkGatewayConnectionSharedKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "4-v3ry-53cr37-1p53c-5h4r3d-k3y"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("shared_key").HasValue("4-v3ry-53cr37-1p53c-5h4r3d-k3y"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "r07473d-5h4r3d-k3y"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("shared_key").HasValue("r07473d-5h4r3d-k3y"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkGatewayConnectionSharedKey_generated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway_connection_shared_key", "test")
+	r := VirtualNetworkGatewayConnectionSharedKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.generated(data, 32),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("shared_key").Exists(),
+			),
+		},
+		data.ImportStep("key_length"),
+		{
+			Config: r.generated(data, 64),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("shared_key").Exists(),
+			),
+		},
+		data.ImportStep("key_length"),
+	})
+}
+
+func (VirtualNetworkGatewayConnectionSharedKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkGatewayConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VnetGatewayConnectionsClient.GetSharedKey(ctx, id.ResourceGroup, id.ConnectionName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Shared Key for %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.Value != nil), nil
+}
+
+func (VirtualNetworkGatewayConnectionSharedKeyResource) basic(data acceptance.TestData, sharedKey string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_virtual_network_gateway_connection_shared_key" "test" {
+  virtual_network_gateway_connection_id = azurestack_virtual_network_gateway_connection.test.id
+  shared_key                            = "%s"
+}
+`, VirtualNetworkGatewayConnectionResource{}.sitetositeWithoutSharedKey(data), sharedKey)
+}
+
+func (VirtualNetworkGatewayConnectionSharedKeyResource) generated(data acceptance.TestData, keyLength int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_virtual_network_gateway_connection_shared_key" "test" {
+  virtual_network_gateway_connection_id = azurestack_virtual_network_gateway_connection.test.id
+  key_length                            = %d
+}
+`, VirtualNetworkGatewayConnectionResource{}.sitetositeWithoutSharedKey(data), keyLength)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+const (
+	vpnClientPackageTypeVpnProfile       = "VpnProfile"
+	vpnClientPackageTypeVpnClientPackage = "VpnClientPackage"
+)
+
+func virtualNetworkGatewayVpnClientPackageDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: virtualNetworkGatewayVpnClientPackageDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"package_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  vpnClientPackageTypeVpnProfile,
+				ValidateFunc: validation.StringInSlice([]string{
+					vpnClientPackageTypeVpnProfile,
+					vpnClientPackageTypeVpnClientPackage,
+				}, false),
+			},
+
+			"processor_architecture": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.Amd64),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Amd64),
+					string(network.X86),
+				}, false),
+			},
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.EAPTLS),
+					string(network.EAPMSCHAPv2),
+				}, false),
+			},
+
+			"radius_server_auth_certificate": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"client_root_certificates": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"output_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"package_url": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func virtualNetworkGatewayVpnClientPackageDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VpnClientParameters{
+		ProcessorArchitecture: network.ProcessorArchitecture(d.Get("processor_architecture").(string)),
+	}
+	if v := d.Get("authentication_method").(string); v != "" {
+		parameters.AuthenticationMethod = network.AuthenticationMethod(v)
+	}
+	if v := d.Get("radius_server_auth_certificate").(string); v != "" {
+		parameters.RadiusServerAuthCertificate = utils.String(v)
+	}
+	if v := d.Get("client_root_certificates").([]interface{}); len(v) > 0 {
+		parameters.ClientRootCertificates = utils.ExpandStringSlice(v)
+	}
+
+	var packageUrl *string
+	switch d.Get("package_type").(string) {
+	case vpnClientPackageTypeVpnClientPackage:
+		future, err := client.Generatevpnclientpackage(ctx, id.ResourceGroup, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("generating VPN Client Package for %s: %+v", *id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for generation of VPN Client Package for %s: %+v", *id, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving VPN Client Package for %s: %+v", *id, err)
+		}
+		packageUrl = result.Value
+
+	default:
+		future, err := client.GenerateVpnProfile(ctx, id.ResourceGroup, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("generating VPN Profile for %s: %+v", *id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for generation of VPN Profile for %s: %+v", *id, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving VPN Profile for %s: %+v", *id, err)
+		}
+		packageUrl = result.Value
+	}
+
+	if packageUrl == nil || *packageUrl == "" {
+		return fmt.Errorf("generating VPN Client Package for %s: the package URL was nil", *id)
+	}
+
+	if outputDirectory := d.Get("output_directory").(string); outputDirectory != "" {
+		if err := downloadVpnClientPackage(ctx, *packageUrl, outputDirectory); err != nil {
+			return fmt.Errorf("downloading VPN Client Package for %s into %q: %+v", *id, outputDirectory, err)
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("package_url", packageUrl)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type VirtualNetworkGatewayVpnClientPackageDataSource struct{}
+
+func TestAccVirtualNetworkGatewayVpnClientPackageDataSource_vpnProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_virtual_network_gateway_vpn_client_package", "test")
+	r := VirtualNetworkGatewayVpnClientPackageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.vpnProfile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("package_url").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccVirtualNetworkGatewayVpnClientPackageDataSource_vpnClientPackage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_virtual_network_gateway_vpn_client_package", "test")
+	r := VirtualNetworkGatewayVpnClientPackageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.vpnClientPackage(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("package_url").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayVpnClientPackageDataSource) vpnProfile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_virtual_network_gateway_vpn_client_package" "test" {
+  virtual_network_gateway_id = azurestack_virtual_network_gateway.test.id
+  authentication_method      = "EAPMSCHAPv2"
+}
+`, VirtualNetworkGatewayResource{}.vpnClientConfig(data))
+}
+
+func (VirtualNetworkGatewayVpnClientPackageDataSource) vpnClientPackage(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_virtual_network_gateway_vpn_client_package" "test" {
+  virtual_network_gateway_id = azurestack_virtual_network_gateway.test.id
+  package_type               = "VpnClientPackage"
+  processor_architecture     = "X86"
+  authentication_method      = "EAPMSCHAPv2"
+}
+`, VirtualNetworkGatewayResource{}.vpnClientConfig(data))
+}
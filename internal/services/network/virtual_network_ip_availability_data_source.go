@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func virtualNetworkIPAvailabilityDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: virtualNetworkIPAvailabilityDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"ip_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				AtLeastOneOf: []string{"ip_address", "subnet_name"},
+			},
+
+			"subnet_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: []string{"ip_address", "subnet_name"},
+			},
+
+			"address_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			"available": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"available_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"next_available_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func virtualNetworkIPAvailabilityDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	subnetsClient := meta.(*clients.Client).Network.SubnetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string))
+
+	available := false
+	availableIPAddresses := make([]interface{}, 0)
+	if ipAddress := d.Get("ip_address").(string); ipAddress != "" {
+		resp, err := client.CheckIPAddressAvailability(ctx, id.ResourceGroup, id.Name, ipAddress)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Error: %s was not found", id)
+			}
+
+			return fmt.Errorf("checking availability of IP Address %q in %s: %+v", ipAddress, id, err)
+		}
+
+		if resp.Available != nil {
+			available = *resp.Available
+		}
+		availableIPAddresses = utils.FlattenStringSlice(resp.AvailableIPAddresses)
+	}
+
+	nextAvailableIPAddresses := make([]interface{}, 0)
+	if subnetName := d.Get("subnet_name").(string); subnetName != "" {
+		subnetId := parse.NewSubnetID(subscriptionId, id.ResourceGroup, id.Name, subnetName)
+		subnet, err := subnetsClient.Get(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(subnet.Response) {
+				return fmt.Errorf("Error: %s was not found", subnetId)
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", subnetId, err)
+		}
+
+		if subnet.SubnetPropertiesFormat == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", subnetId)
+		}
+
+		// only IPv4 addresses can be checked, so for a dual-stack subnet use the IPv4 prefix
+		addressPrefix := ""
+		_, addressPrefixes := flattenSubnetAddressPrefixes(subnet.SubnetPropertiesFormat)
+		for _, v := range addressPrefixes {
+			if ip, _, err := net.ParseCIDR(v.(string)); err == nil && ip.To4() != nil {
+				addressPrefix = v.(string)
+				break
+			}
+		}
+		if addressPrefix == "" {
+			return fmt.Errorf("%s has no IPv4 address prefix", subnetId)
+		}
+
+		addresses, err := findNextAvailableIPAddresses(ctx, client, id, addressPrefix, d.Get("address_count").(int))
+		if err != nil {
+			return fmt.Errorf("finding available IP Addresses in %s: %+v", subnetId, err)
+		}
+		for _, address := range addresses {
+			nextAvailableIPAddresses = append(nextAvailableIPAddresses, address)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("available", available)
+	if err := d.Set("available_ip_addresses", availableIPAddresses); err != nil {
+		return fmt.Errorf("setting `available_ip_addresses`: %+v", err)
+	}
+	if err := d.Set("next_available_ip_addresses", nextAvailableIPAddresses); err != nil {
+		return fmt.Errorf("setting `next_available_ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
+
+// findNextAvailableIPAddresses walks the usable addresses in the specified prefix in order, returning the
+// first `count` addresses which the Virtual Network reports as being available
+func findNextAvailableIPAddresses(ctx context.Context, client *network.VirtualNetworksClient, id parse.VirtualNetworkId, addressPrefix string, count int) ([]string, error) {
+	current, last, err := usableIPv4AddressRange(addressPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0)
+	for len(results) < count && compareIPv4Addresses(current, last) <= 0 {
+		resp, err := client.CheckIPAddressAvailability(ctx, id.ResourceGroup, id.Name, current.String())
+		if err != nil {
+			return nil, fmt.Errorf("checking availability of IP Address %q: %+v", current.String(), err)
+		}
+
+		if resp.Available != nil && *resp.Available {
+			results = append(results, current.String())
+			current = nextIPv4Address(current)
+			continue
+		}
+
+		// when the address is in use the API suggests the closest free addresses, so we can skip
+		// straight to the lowest of those rather than checking each address in turn
+		next := nextIPv4Address(current)
+		if resp.AvailableIPAddresses != nil {
+			var lowest net.IP
+			for _, v := range *resp.AvailableIPAddresses {
+				candidate := net.ParseIP(v)
+				if candidate == nil || candidate.To4() == nil || compareIPv4Addresses(candidate, current) <= 0 {
+					continue
+				}
+				if lowest == nil || compareIPv4Addresses(candidate, lowest) < 0 {
+					lowest = candidate
+				}
+			}
+			if lowest != nil {
+				next = lowest.To4()
+			}
+		}
+		current = next
+	}
+
+	if len(results) < count {
+		return nil, fmt.Errorf("only %d of the %d requested IP Addresses are available in %q", len(results), count, addressPrefix)
+	}
+
+	return results, nil
+}
@@ -341,9 +341,7 @@ func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
 			}
 
 			if props := subnet.SubnetPropertiesFormat; props != nil {
-				if prefix := props.AddressPrefix; prefix != nil {
-					output["address_prefix"] = *prefix
-				}
+				output["address_prefix"], _ = flattenSubnetAddressPrefixes(props)
 
 				if nsg := props.NetworkSecurityGroup; nsg != nil {
 					if nsg.ID != nil {
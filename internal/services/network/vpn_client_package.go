@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// vpnClientPackageMaxSizeInBytes caps the size of the package which will be downloaded into memory
+const vpnClientPackageMaxSizeInBytes = 100 * 1024 * 1024
+
+func downloadVpnClientPackage(ctx context.Context, packageUrl string, outputDirectory string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, packageUrl, nil)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, vpnClientPackageMaxSizeInBytes+1))
+	if err != nil {
+		return fmt.Errorf("reading response: %+v", err)
+	}
+	if len(data) > vpnClientPackageMaxSizeInBytes {
+		return fmt.Errorf("the package is larger than %d bytes", vpnClientPackageMaxSizeInBytes)
+	}
+
+	return extractVpnClientPackage(data, outputDirectory)
+}
+
+// extractVpnClientPackage unzips the package into the specified directory, refusing to write any
+// entries which would end up outside of it
+func extractVpnClientPackage(data []byte, outputDirectory string) error {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("opening package as a zip archive: %+v", err)
+	}
+
+	root, err := filepath.Abs(outputDirectory)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", root, err)
+	}
+
+	for _, file := range reader.File {
+		// the packages are generated on Windows, so normalize the path separators
+		name := strings.ReplaceAll(file.Name, `\`, "/")
+		target := filepath.Join(root, filepath.FromSlash(name))
+		if target != root && !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+			return fmt.Errorf("the package entry %q would be extracted outside of %q", file.Name, root)
+		}
+
+		if file.FileInfo().IsDir() || strings.HasSuffix(name, "/") {
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return fmt.Errorf("creating directory %q: %+v", target, err)
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("creating directory %q: %+v", filepath.Dir(target), err)
+		}
+
+		log.Printf("[DEBUG] Extracting %q to %q", file.Name, target)
+		if err := extractVpnClientPackageFile(file, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func extractVpnClientPackageFile(file *zip.File, target string) error {
+	src, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("opening package entry %q: %+v", file.Name, err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("creating %q: %+v", target, err)
+	}
+	defer dst.Close()
+
+	written, err := io.Copy(dst, io.LimitReader(src, vpnClientPackageMaxSizeInBytes+1))
+	if err != nil {
+		return fmt.Errorf("writing %q: %+v", target, err)
+	}
+	if written > vpnClientPackageMaxSizeInBytes {
+		return fmt.Errorf("the package entry %q is larger than %d bytes", file.Name, vpnClientPackageMaxSizeInBytes)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtractVpnClientPackage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Entries  map[string]string
+		Expected []string
+		Error    bool
+	}{
+		{
+			Name: "Nested Entries",
+			Entries: map[string]string{
+				"Generic/VpnSettings.xml":    "<VpnProfile />",
+				`WindowsAmd64\VpnClient.exe`: "binary",
+			},
+			Expected: []string{
+				"Generic/VpnSettings.xml",
+				"WindowsAmd64/VpnClient.exe",
+			},
+		},
+		{
+			Name: "Path Traversal",
+			Entries: map[string]string{
+				"../escaped.txt": "nope",
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		buf := new(bytes.Buffer)
+		writer := zip.NewWriter(buf)
+		for name, content := range v.Entries {
+			f, err := writer.Create(name)
+			if err != nil {
+				t.Fatalf("creating zip entry %q: %+v", name, err)
+			}
+			if _, err := f.Write([]byte(content)); err != nil {
+				t.Fatalf("writing zip entry %q: %+v", name, err)
+			}
+		}
+		if err := writer.Close(); err != nil {
+			t.Fatalf("closing zip: %+v", err)
+		}
+
+		dir := t.TempDir()
+		err := extractVpnClientPackage(buf.Bytes(), filepath.Join(dir, "package"))
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		for _, expected := range v.Expected {
+			if _, err := os.Stat(filepath.Join(dir, "package", filepath.FromSlash(expected))); err != nil {
+				t.Fatalf("expected %q to have been extracted: %+v", expected, err)
+			}
+		}
+	}
+}